@@ -1,6 +1,14 @@
 package mongodbatlas
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
 	digest "github.com/Sectorbob/mlab-ns2/gae/ns/digest"
 	"github.com/hashicorp/terraform/helper/logging"
 	matlasClient "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
@@ -10,21 +18,208 @@ import (
 type Config struct {
 	PublicKey  string
 	PrivateKey string
+
+	RetryOnSnapshotInProgress bool
+	PreventClusterDeletion    bool
+}
+
+//MongoDBClient is the provider meta shared by all resources and data sources.
+type MongoDBClient struct {
+	Atlas *matlasClient.Client
+
+	//Realm is the client of the Realm admin API, authenticated with the same API key.
+	Realm *realmClient
+
+	//projectMutexKV serializes the mutations of project scoped lists, e.g. the IP whitelist.
+	projectMutexKV *mutexKV
+
+	//responseCache caches immutable reference data, e.g. the cloud provider options.
+	responseCache *responseCache
+
+	//retryOnSnapshotInProgress retries the cluster updates rejected while a snapshot is in progress.
+	retryOnSnapshotInProgress bool
+
+	//preventClusterDeletion fails the deletion of every cluster, see validateClusterDeletion.
+	preventClusterDeletion bool
 }
 
 //NewClient ...
-func (c *Config) NewClient() interface{} {
+func (c *Config) NewClient() (interface{}, error) {
 	// setup a transport to handle digest
 	transport := digest.NewTransport(c.PublicKey, c.PrivateKey)
 
 	// initialize the client
 	client, err := transport.Client()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	client.Transport = logging.NewTransport("MongoDB Atlas", transport)
+	client.Transport = &atlasErrorTransport{transport: logging.NewTransport("MongoDB Atlas", transport)}
 
 	//Initialize the MongoDB Atlas API Client.
-	return matlasClient.NewClient(client)
+	return &MongoDBClient{
+		Atlas:          matlasClient.NewClient(client),
+		Realm:          newRealmClient(&http.Client{Transport: logging.NewTransport("MongoDB Realm", http.DefaultTransport)}, realmBaseURL, c.PublicKey, c.PrivateKey),
+		projectMutexKV: newMutexKV(),
+		responseCache:  newResponseCache(responseCacheTTL),
+
+		retryOnSnapshotInProgress: c.RetryOnSnapshotInProgress,
+		preventClusterDeletion:    c.PreventClusterDeletion,
+	}, nil
+}
+
+//atlasErrorTransport keeps the body of the Atlas error responses once the client decoded them,
+//the client drops the `errorCode` of the body so AtlasErrorCode reads it from there.
+type atlasErrorTransport struct {
+	transport http.RoundTripper
+}
+
+func (t *atlasErrorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil || resp.StatusCode < http.StatusBadRequest {
+		return resp, err
+	}
+
+	data, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = &atlasErrorBody{Reader: bytes.NewReader(data), data: data}
+	return resp, nil
+}
+
+//atlasErrorBody is the body of an Atlas error response, it can be read again after the client
+//consumed it.
+type atlasErrorBody struct {
+	*bytes.Reader
+	data []byte
+}
+
+func (b *atlasErrorBody) Close() error {
+	return nil
+}
+
+//AtlasErrorCode returns the Atlas error code of the response that caused err, e.g.
+//GROUP_NOT_FOUND, or "" if err isn't an Atlas error response. Errors wrapped with a message
+//aren't Atlas error responses anymore, so it must be called with the error of the client.
+func AtlasErrorCode(err error) string {
+	errorResponse, ok := err.(*matlasClient.ErrorResponse)
+	if !ok || errorResponse.Response == nil {
+		return ""
+	}
+
+	body, ok := errorResponse.Response.Body.(*atlasErrorBody)
+	if !ok {
+		return ""
+	}
+
+	root := new(struct {
+		ErrorCode string `json:"errorCode"`
+	})
+	if err := json.Unmarshal(body.data, root); err != nil {
+		return ""
+	}
+	return root.ErrorCode
+}
+
+//atlasV2AcceptHeader selects the version of the resources of the versioned API.
+const atlasV2AcceptHeader = "application/vnd.atlas.2023-11-15+json"
+
+//doAtlasV2Request sends a request to the versioned API, whose paths are relative to the
+//base URL of the client, e.g. `../v2/groups/%s`.
+func doAtlasV2Request(conn *matlasClient.Client, method, path string, body, v interface{}) (*matlasClient.Response, error) {
+	req, err := conn.NewRequest(context.Background(), method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", atlasV2AcceptHeader)
+
+	return conn.Do(context.Background(), req, v)
+}
+
+//mutexKV is a simple key/value store of mutexes, used to serialize operations
+//that share the same key (e.g. a project ID) within a single Terraform process.
+type mutexKV struct {
+	lock  sync.Mutex
+	store map[string]*sync.Mutex
+}
+
+func newMutexKV() *mutexKV {
+	return &mutexKV{
+		store: make(map[string]*sync.Mutex),
+	}
+}
+
+//Lock locks the mutex for the given key, creating it if needed.
+func (m *mutexKV) Lock(key string) {
+	m.get(key).Lock()
+}
+
+//Unlock unlocks the mutex for the given key.
+func (m *mutexKV) Unlock(key string) {
+	m.get(key).Unlock()
+}
+
+func (m *mutexKV) get(key string) *sync.Mutex {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	mutex, ok := m.store[key]
+	if !ok {
+		mutex = &sync.Mutex{}
+		m.store[key] = mutex
+	}
+	return mutex
+}
+
+//responseCacheTTL is how long the cached responses are kept, long enough to cover a single
+//plan or apply without keeping data around for long-running processes.
+const responseCacheTTL = 5 * time.Minute
+
+//responseCache is a short-lived in-memory cache of API responses. It must only be used for
+//immutable reference data, mutable resources must always be read from the API.
+type responseCache struct {
+	lock    sync.Mutex
+	ttl     time.Duration
+	entries map[string]responseCacheEntry
+}
+
+type responseCacheEntry struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+func newResponseCache(ttl time.Duration) *responseCache {
+	return &responseCache{
+		ttl:     ttl,
+		entries: make(map[string]responseCacheEntry),
+	}
+}
+
+//Fetch returns the cached value for the key, calling fetch to load it if it's missing or
+//expired. Errors aren't cached. A nil cache always calls fetch.
+func (c *responseCache) Fetch(key string, fetch func() (interface{}, error)) (interface{}, error) {
+	if c == nil {
+		return fetch()
+	}
+
+	c.lock.Lock()
+	entry, ok := c.entries[key]
+	c.lock.Unlock()
+
+	if ok && time.Now().Before(entry.expiresAt) {
+		return entry.value, nil
+	}
+
+	value, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	c.entries[key] = responseCacheEntry{value: value, expiresAt: time.Now().Add(c.ttl)}
+	c.lock.Unlock()
+
+	return value, nil
 }
@@ -0,0 +1,127 @@
+package mongodbatlas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+func TestResponseCacheFetch(t *testing.T) {
+	cache := newResponseCache(20 * time.Millisecond)
+	calls := 0
+	fetch := func() (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+
+	for i := 0; i < 3; i++ {
+		v, err := cache.Fetch("AWS:regions", fetch)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if v.(int) != 1 {
+			t.Fatalf("expected cached value 1, got %v", v)
+		}
+	}
+
+	if _, err := cache.Fetch("GCP:regions", fetch); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected a fetch per key, got %d calls", calls)
+	}
+
+	time.Sleep(30 * time.Millisecond)
+	if v, _ := cache.Fetch("AWS:regions", fetch); v.(int) != 3 {
+		t.Fatalf("expected expired entry to be fetched again, got %v", v)
+	}
+}
+
+func TestResponseCacheFetchError(t *testing.T) {
+	cache := newResponseCache(time.Minute)
+	calls := 0
+	fetch := func() (interface{}, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("unavailable")
+		}
+		return "ok", nil
+	}
+
+	if _, err := cache.Fetch("key", fetch); err == nil {
+		t.Fatal("expected error")
+	}
+	if v, err := cache.Fetch("key", fetch); err != nil || v.(string) != "ok" {
+		t.Fatalf("expected errors not to be cached, got %v, %v", v, err)
+	}
+
+	var nilCache *responseCache
+	if v, err := nilCache.Fetch("key", fetch); err != nil || v.(string) != "ok" {
+		t.Fatalf("expected nil cache to call fetch, got %v, %v", v, err)
+	}
+}
+
+func TestAtlasErrorCode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"detail": "No group with ID 5d09d6a59ccf6445652a444a exists.", "error": 404, "errorCode": "GROUP_NOT_FOUND", "parameters": ["5d09d6a59ccf6445652a444a"], "reason": "Not Found"}`)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(&http.Client{Transport: &atlasErrorTransport{transport: http.DefaultTransport}}, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	_, _, err = client.Clusters.Get(context.Background(), "5d09d6a59ccf6445652a444a", "cluster0")
+	if code := AtlasErrorCode(err); code != "GROUP_NOT_FOUND" {
+		t.Fatalf("expected error code GROUP_NOT_FOUND, got %q", code)
+	}
+	if !isProjectNotFoundError(err) {
+		t.Fatal("expected the error to be classified as project not found")
+	}
+
+	// The detail of the error must still be decoded by the client.
+	if errorResponse, ok := err.(*matlas.ErrorResponse); !ok || errorResponse.Detail != "No group with ID 5d09d6a59ccf6445652a444a exists." {
+		t.Fatalf("unexpected error %#v", err)
+	}
+
+	for _, err := range []error{nil, errors.New("GROUP_NOT_FOUND"), fmt.Errorf("error reading cluster: %s", err)} {
+		if code := AtlasErrorCode(err); code != "" {
+			t.Fatalf("expected no error code for %v, got %q", err, code)
+		}
+	}
+}
+
+func TestDoAtlasV2Request(t *testing.T) {
+	var path, accept string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, accept = r.URL.Path, r.Header.Get("Accept")
+		fmt.Fprint(w, `{"name": "cluster0"}`)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/api/atlas/v1.0/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	v := new(struct {
+		Name string `json:"name"`
+	})
+	if _, err := doAtlasV2Request(client, http.MethodGet, "../v2/groups/5d09d6a59ccf6445652a444a/clusters/cluster0", nil, v); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if path != "/api/atlas/v2/groups/5d09d6a59ccf6445652a444a/clusters/cluster0" || accept != atlasV2AcceptHeader {
+		t.Fatalf("expected a request to the versioned API, got %s with Accept %q", path, accept)
+	}
+	if v.Name != "cluster0" {
+		t.Fatalf("expected the response to be decoded, got %+v", v)
+	}
+}
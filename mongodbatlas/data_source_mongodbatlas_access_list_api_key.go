@@ -0,0 +1,125 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+const apiKeyAccessListPath = "orgs/%s/apiKeys/%s/accessList"
+
+// apiKeyAccessListEntry is an IP address or CIDR block allowed to use an API key.
+// See more: https://docs.atlas.mongodb.com/reference/api/apiKeys-org-accesslist-get-all/
+type apiKeyAccessListEntry struct {
+	CIDRBlock       string `json:"cidrBlock,omitempty"`
+	IPAddress       string `json:"ipAddress,omitempty"`
+	Created         string `json:"created,omitempty"`
+	LastUsed        string `json:"lastUsed,omitempty"`
+	LastUsedAddress string `json:"lastUsedAddress,omitempty"`
+	Count           int64  `json:"count,omitempty"`
+}
+
+func dataSourceMongoDBAtlasAccessListAPIKey() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMongoDBAtlasAccessListAPIKeyRead,
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"api_key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_used": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_used_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"access_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasAccessListAPIKeyRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	orgID := d.Get("org_id").(string)
+	apiKeyID := d.Get("api_key_id").(string)
+
+	entries, err := getAPIKeyAccessList(conn, orgID, apiKeyID)
+	if err != nil {
+		return fmt.Errorf("error getting access list of API key (%s): %s", apiKeyID, err)
+	}
+
+	if err := d.Set("results", flattenAPIKeyAccessList(entries)); err != nil {
+		return fmt.Errorf("error setting `results` for access list of API key (%s): %s", apiKeyID, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"org_id":     orgID,
+		"api_key_id": apiKeyID,
+	}))
+
+	return nil
+}
+
+func getAPIKeyAccessList(conn *matlas.Client, orgID, apiKeyID string) ([]*apiKeyAccessListEntry, error) {
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, fmt.Sprintf(apiKeyAccessListPath, orgID, apiKeyID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	root := new(struct {
+		Results []*apiKeyAccessListEntry `json:"results"`
+	})
+	if _, err := conn.Do(context.Background(), req, root); err != nil {
+		return nil, err
+	}
+	return root.Results, nil
+}
+
+func flattenAPIKeyAccessList(entries []*apiKeyAccessListEntry) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0, len(entries))
+	for _, entry := range entries {
+		results = append(results, map[string]interface{}{
+			"cidr_block":        entry.CIDRBlock,
+			"ip_address":        entry.IPAddress,
+			"created":           entry.Created,
+			"last_used":         entry.LastUsed,
+			"last_used_address": entry.LastUsedAddress,
+			"access_count":      entry.Count,
+		})
+	}
+	return results
+}
@@ -0,0 +1,80 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+func TestAccDataSourceMongoDBAtlasAccessListAPIKey_basic(t *testing.T) {
+	dataSourceName := "data.mongodbatlas_access_list_api_key.test"
+	orgID := os.Getenv("MONGODB_ATLAS_ORG_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasAccessListAPIKeyDSConfig(orgID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "org_id", orgID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "api_key_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "results.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestGetAPIKeyAccessList(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/orgs/5b93ff2f96e82120w0aaec19/apiKeys/5d1285acd5ec13b6c2d1726a/accessList" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"results": [{"cidrBlock": "10.0.0.0/24", "created": "2020-03-01T10:02:11Z", "lastUsed": "2020-03-02T10:02:11Z", "lastUsedAddress": "10.0.0.12", "count": 42}], "totalCount": 1}`)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	entries, err := getAPIKeyAccessList(client, "5b93ff2f96e82120w0aaec19", "5d1285acd5ec13b6c2d1726a")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"cidr_block":        "10.0.0.0/24",
+			"ip_address":        "",
+			"created":           "2020-03-01T10:02:11Z",
+			"last_used":         "2020-03-02T10:02:11Z",
+			"last_used_address": "10.0.0.12",
+			"access_count":      int64(42),
+		},
+	}
+	if results := flattenAPIKeyAccessList(entries); !reflect.DeepEqual(results, expected) {
+		t.Fatalf("expected %v, got %v", expected, results)
+	}
+}
+
+func testAccMongoDBAtlasAccessListAPIKeyDSConfig(orgID string) string {
+	return fmt.Sprintf(`
+		data "mongodbatlas_api_keys" "test" {
+			org_id = "%s"
+		}
+
+		data "mongodbatlas_access_list_api_key" "test" {
+			org_id     = data.mongodbatlas_api_keys.test.org_id
+			api_key_id = data.mongodbatlas_api_keys.test.results.0.api_key_id
+		}
+	`, orgID)
+}
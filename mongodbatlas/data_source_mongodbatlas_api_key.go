@@ -0,0 +1,86 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+const (
+	errorAPIKeyRead    = "error getting API key (%s): %s"
+	errorAPIKeySetting = "error setting `%s` for API key (%s): %s"
+)
+
+func dataSourceMongoDBAtlasAPIKey() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMongoDBAtlasAPIKeyRead,
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"api_key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"public_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasAPIKeyRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	orgID := d.Get("org_id").(string)
+	apiKeyID := d.Get("api_key_id").(string)
+
+	apiKey, _, err := conn.APIKeys.Get(context.Background(), orgID, apiKeyID)
+	if err != nil {
+		return fmt.Errorf(errorAPIKeyRead, apiKeyID, err)
+	}
+
+	// The private key is only returned when the key is created, it's never exposed anyway.
+	if err := d.Set("public_key", apiKey.PublicKey); err != nil {
+		return fmt.Errorf(errorAPIKeySetting, "public_key", apiKeyID, err)
+	}
+	if err := d.Set("description", apiKey.Desc); err != nil {
+		return fmt.Errorf(errorAPIKeySetting, "description", apiKeyID, err)
+	}
+	if err := d.Set("role_names", flattenAPIKeyRoleNames(apiKey.Roles, func(role matlas.APIKeyRole) bool { return role.OrgID == orgID })); err != nil {
+		return fmt.Errorf(errorAPIKeySetting, "role_names", apiKeyID, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"org_id":     orgID,
+		"api_key_id": apiKeyID,
+	}))
+
+	return nil
+}
+
+// flattenAPIKeyRoleNames returns the names of the roles of the API key granted in the
+// organization or project selected by match.
+func flattenAPIKeyRoleNames(roles []matlas.APIKeyRole, match func(matlas.APIKeyRole) bool) []string {
+	roleNames := make([]string, 0, len(roles))
+	for _, role := range roles {
+		if match(role) {
+			roleNames = append(roleNames, role.RoleName)
+		}
+	}
+	return roleNames
+}
@@ -0,0 +1,42 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceMongoDBAtlasAPIKey_basic(t *testing.T) {
+	dataSourceName := "data.mongodbatlas_api_key.test"
+	orgID := os.Getenv("MONGODB_ATLAS_ORG_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasAPIKeyDSConfig(orgID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "api_key_id", "data.mongodbatlas_api_keys.test", "results.0.api_key_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "public_key", "data.mongodbatlas_api_keys.test", "results.0.public_key"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "role_names.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMongoDBAtlasAPIKeyDSConfig(orgID string) string {
+	return fmt.Sprintf(`
+		data "mongodbatlas_api_keys" "test" {
+			org_id = "%s"
+		}
+
+		data "mongodbatlas_api_key" "test" {
+			org_id     = data.mongodbatlas_api_keys.test.org_id
+			api_key_id = data.mongodbatlas_api_keys.test.results.0.api_key_id
+		}
+	`, orgID)
+}
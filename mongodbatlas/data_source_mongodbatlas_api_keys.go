@@ -0,0 +1,127 @@
+package mongodbatlas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+// apiKeysPageSize is the number of API keys requested per page, the maximum allowed by Atlas.
+const apiKeysPageSize = 500
+
+func dataSourceMongoDBAtlasAPIKeys() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMongoDBAtlasAPIKeysRead,
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"project_id"},
+			},
+			"project_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"org_id"},
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_key_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role_names": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasAPIKeysRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	orgID := d.Get("org_id").(string)
+	projectID := d.Get("project_id").(string)
+
+	var (
+		list  func(*matlas.ListOptions) ([]matlas.APIKey, *matlas.Response, error)
+		match func(matlas.APIKeyRole) bool
+	)
+	switch {
+	case orgID != "":
+		list = func(options *matlas.ListOptions) ([]matlas.APIKey, *matlas.Response, error) {
+			return conn.APIKeys.List(context.Background(), orgID, options)
+		}
+		match = func(role matlas.APIKeyRole) bool { return role.OrgID == orgID }
+	case projectID != "":
+		list = func(options *matlas.ListOptions) ([]matlas.APIKey, *matlas.Response, error) {
+			return conn.ProjectAPIKeys.List(context.Background(), projectID, options)
+		}
+		match = func(role matlas.APIKeyRole) bool { return role.GroupID == projectID }
+	default:
+		return errors.New("one of `org_id` or `project_id` must be set to list the API keys")
+	}
+
+	apiKeys, err := listAllAPIKeys(list)
+	if err != nil {
+		return fmt.Errorf("error getting API keys: %s", err)
+	}
+
+	if err := d.Set("results", flattenAPIKeys(apiKeys, match)); err != nil {
+		return fmt.Errorf("error setting `results` for API keys: %s", err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"org_id":     orgID,
+		"project_id": projectID,
+	}))
+
+	return nil
+}
+
+// listAllAPIKeys requests the pages of API keys until a page isn't full.
+func listAllAPIKeys(list func(*matlas.ListOptions) ([]matlas.APIKey, *matlas.Response, error)) ([]matlas.APIKey, error) {
+	var apiKeys []matlas.APIKey
+	for page := 1; ; page++ {
+		results, _, err := list(&matlas.ListOptions{PageNum: page, ItemsPerPage: apiKeysPageSize})
+		if err != nil {
+			return nil, err
+		}
+		apiKeys = append(apiKeys, results...)
+		if len(results) < apiKeysPageSize {
+			return apiKeys, nil
+		}
+	}
+}
+
+func flattenAPIKeys(apiKeys []matlas.APIKey, match func(matlas.APIKeyRole) bool) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		results = append(results, map[string]interface{}{
+			"api_key_id":  apiKey.ID,
+			"public_key":  apiKey.PublicKey,
+			"description": apiKey.Desc,
+			"role_names":  flattenAPIKeyRoleNames(apiKey.Roles, match),
+		})
+	}
+	return results
+}
@@ -0,0 +1,88 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+func TestAccDataSourceMongoDBAtlasAPIKeys_basic(t *testing.T) {
+	dataSourceName := "data.mongodbatlas_api_keys.test"
+	orgID := os.Getenv("MONGODB_ATLAS_ORG_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasAPIKeysDSConfig(orgID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "org_id", orgID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "results.0.api_key_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "results.0.public_key"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "results.0.private_key"),
+				),
+			},
+		},
+	})
+}
+
+func TestListAllAPIKeys(t *testing.T) {
+	var pages []int
+
+	apiKeys, err := listAllAPIKeys(func(options *matlas.ListOptions) ([]matlas.APIKey, *matlas.Response, error) {
+		pages = append(pages, options.PageNum)
+		if options.PageNum == 1 {
+			return make([]matlas.APIKey, apiKeysPageSize), nil, nil
+		}
+		return []matlas.APIKey{{ID: "5d1285acd5ec13b6c2d1726a"}}, nil, nil
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(apiKeys) != apiKeysPageSize+1 || !reflect.DeepEqual(pages, []int{1, 2}) {
+		t.Fatalf("expected two pages of API keys, got %d keys from pages %v", len(apiKeys), pages)
+	}
+}
+
+func TestFlattenAPIKeys(t *testing.T) {
+	apiKeys := []matlas.APIKey{
+		{
+			ID:         "5d1285acd5ec13b6c2d1726a",
+			Desc:       "rotation",
+			PublicKey:  "abcdefgh",
+			PrivateKey: "secret",
+			Roles: []matlas.APIKeyRole{
+				{OrgID: "5b93ff2f96e82120w0aaec19", RoleName: "ORG_MEMBER"},
+				{GroupID: "5d09d6a59ccf6445652a444a", RoleName: "GROUP_OWNER"},
+				{GroupID: "5d09d6a59ccf6445652a444b", RoleName: "GROUP_READ_ONLY"},
+			},
+		},
+	}
+
+	results := flattenAPIKeys(apiKeys, func(role matlas.APIKeyRole) bool { return role.GroupID == "5d09d6a59ccf6445652a444a" })
+
+	expected := []map[string]interface{}{
+		{
+			"api_key_id":  "5d1285acd5ec13b6c2d1726a",
+			"public_key":  "abcdefgh",
+			"description": "rotation",
+			"role_names":  []string{"GROUP_OWNER"},
+		},
+	}
+	if !reflect.DeepEqual(results, expected) {
+		t.Fatalf("expected %v, got %v", expected, results)
+	}
+}
+
+func testAccMongoDBAtlasAPIKeysDSConfig(orgID string) string {
+	return fmt.Sprintf(`
+		data "mongodbatlas_api_keys" "test" {
+			org_id = "%s"
+		}
+	`, orgID)
+}
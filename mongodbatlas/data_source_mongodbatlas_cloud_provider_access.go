@@ -0,0 +1,133 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+const cloudProviderAccessPath = "groups/%s/cloudProviderAccess"
+
+// cloudProviderAccessRoles represents the cloud provider access roles of a project.
+// See more: https://docs.atlas.mongodb.com/reference/api/cloud-provider-access-get-roles/
+type cloudProviderAccessRoles struct {
+	AWSIAMRoles []*awsIAMRole `json:"awsIamRoles,omitempty"`
+}
+
+// awsIAMRole is an AWS IAM role that Atlas assumes to access the resources of an AWS account.
+type awsIAMRole struct {
+	RoleID                     string `json:"roleId,omitempty"`
+	ProviderName               string `json:"providerName,omitempty"`
+	AtlasAWSAccountARN         string `json:"atlasAWSAccountArn,omitempty"`
+	AtlasAssumedRoleExternalID string `json:"atlasAssumedRoleExternalId,omitempty"`
+	IAMAssumedRoleARN          string `json:"iamAssumedRoleArn,omitempty"`
+	CreatedDate                string `json:"createdDate,omitempty"`
+	AuthorizedDate             string `json:"authorizedDate,omitempty"`
+}
+
+func dataSourceMongoDBAtlasCloudProviderAccess() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMongoDBAtlasCloudProviderAccessRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"aws_iam_roles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"provider_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"atlas_aws_account_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"atlas_assumed_role_external_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"iam_assumed_role_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"authorized_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"authorized": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasCloudProviderAccessRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+
+	roles, _, err := getCloudProviderAccessRoles(conn, projectID)
+	if err != nil {
+		return fmt.Errorf("error getting cloud provider access roles for project (%s): %s", projectID, err)
+	}
+
+	if err := d.Set("aws_iam_roles", flattenAWSIAMRoles(roles.AWSIAMRoles)); err != nil {
+		return fmt.Errorf("error setting `aws_iam_roles` for project (%s): %s", projectID, err)
+	}
+
+	d.SetId(projectID)
+
+	return nil
+}
+
+func getCloudProviderAccessRoles(conn *matlas.Client, projectID string) (*cloudProviderAccessRoles, *matlas.Response, error) {
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, fmt.Sprintf(cloudProviderAccessPath, projectID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(cloudProviderAccessRoles)
+	resp, err := conn.Do(context.Background(), req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
+
+func flattenAWSIAMRoles(roles []*awsIAMRole) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0, len(roles))
+	for _, role := range roles {
+		results = append(results, map[string]interface{}{
+			"role_id":                        role.RoleID,
+			"provider_name":                  role.ProviderName,
+			"atlas_aws_account_arn":          role.AtlasAWSAccountARN,
+			"atlas_assumed_role_external_id": role.AtlasAssumedRoleExternalID,
+			"iam_assumed_role_arn":           role.IAMAssumedRoleARN,
+			"created_date":                   role.CreatedDate,
+			"authorized_date":                role.AuthorizedDate,
+			// Atlas only sets the authorization date once the IAM role has been authorized.
+			"authorized": role.AuthorizedDate != "",
+		})
+	}
+	return results
+}
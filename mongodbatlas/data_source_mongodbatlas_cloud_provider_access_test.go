@@ -0,0 +1,107 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+func TestAccDataSourceMongoDBAtlasCloudProviderAccess_basic(t *testing.T) {
+	dataSourceName := "data.mongodbatlas_cloud_provider_access.test"
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasCloudProviderAccessDSConfig(projectID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "project_id", projectID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "aws_iam_roles.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestGetCloudProviderAccessRoles(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/groups/5d09d6a59ccf6445652a444a/cloudProviderAccess" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{
+			"awsIamRoles": [
+				{
+					"atlasAWSAccountArn": "arn:aws:iam::772401394250:role/my-test-aws-role",
+					"atlasAssumedRoleExternalId": "24be57ae-0a61-4c4b-bd46-0fc1f7e5d0a0",
+					"authorizedDate": "2020-08-03T20:42:49Z",
+					"createdDate": "2020-07-30T20:20:36Z",
+					"featureUsages": [],
+					"iamAssumedRoleArn": "arn:aws:iam::123456789012:role/test-role",
+					"providerName": "AWS",
+					"roleId": "5f232b94af0a6b41747bcc2d"
+				},
+				{
+					"atlasAWSAccountArn": "arn:aws:iam::772401394250:role/my-test-aws-role",
+					"atlasAssumedRoleExternalId": "c33c5d3f-d5ec-4d38-a6c1-4fa6f1a4e11c",
+					"createdDate": "2020-07-31T10:20:36Z",
+					"featureUsages": [],
+					"providerName": "AWS",
+					"roleId": "5f241a6eaf0a6b41747bcc2e"
+				}
+			]
+		}`)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	roles, _, err := getCloudProviderAccessRoles(client, "5d09d6a59ccf6445652a444a")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"role_id":                        "5f232b94af0a6b41747bcc2d",
+			"provider_name":                  "AWS",
+			"atlas_aws_account_arn":          "arn:aws:iam::772401394250:role/my-test-aws-role",
+			"atlas_assumed_role_external_id": "24be57ae-0a61-4c4b-bd46-0fc1f7e5d0a0",
+			"iam_assumed_role_arn":           "arn:aws:iam::123456789012:role/test-role",
+			"created_date":                   "2020-07-30T20:20:36Z",
+			"authorized_date":                "2020-08-03T20:42:49Z",
+			"authorized":                     true,
+		},
+		{
+			"role_id":                        "5f241a6eaf0a6b41747bcc2e",
+			"provider_name":                  "AWS",
+			"atlas_aws_account_arn":          "arn:aws:iam::772401394250:role/my-test-aws-role",
+			"atlas_assumed_role_external_id": "c33c5d3f-d5ec-4d38-a6c1-4fa6f1a4e11c",
+			"iam_assumed_role_arn":           "",
+			"created_date":                   "2020-07-31T10:20:36Z",
+			"authorized_date":                "",
+			"authorized":                     false,
+		},
+	}
+	if results := flattenAWSIAMRoles(roles.AWSIAMRoles); !reflect.DeepEqual(results, expected) {
+		t.Fatalf("expected %v, got %v", expected, results)
+	}
+}
+
+func testAccMongoDBAtlasCloudProviderAccessDSConfig(projectID string) string {
+	return fmt.Sprintf(`
+		data "mongodbatlas_cloud_provider_access" "test" {
+			project_id = "%s"
+		}
+	`, projectID)
+}
@@ -0,0 +1,167 @@
+package mongodbatlas
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceMongoDBAtlasCloudProviderSnapshotBackupPolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMongoDBAtlasCloudProviderSnapshotBackupPolicyRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"reference_hour_of_day": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"reference_minute_of_hour": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"restore_window_days": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"next_snapshot": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy_items": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"frequency_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"frequency_interval": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"retention_unit": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"retention_value": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"copy_settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloud_provider": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replication_spec_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"should_copy_oplogs": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"frequencies": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasCloudProviderSnapshotBackupPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+	clusterName := d.Get("cluster_name").(string)
+
+	policy, _, err := getCloudProviderSnapshotBackupPolicy(conn, projectID, clusterName)
+	if err != nil {
+		return fmt.Errorf(errorSnapshotBackupPolicyRead, clusterName, err)
+	}
+
+	values := map[string]interface{}{
+		"cluster_id":               policy.ClusterID,
+		"reference_hour_of_day":    policy.ReferenceHourOfDay,
+		"reference_minute_of_hour": policy.ReferenceMinuteOfHour,
+		"restore_window_days":      policy.RestoreWindowDays,
+		"next_snapshot":            policy.NextSnapshot,
+		"policies":                 flattenBackupPolicies(policy.Policies),
+		"copy_settings":            flattenCopySettings(policy.CopySettings),
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf(errorSnapshotBackupPolicyRead, clusterName, err)
+		}
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":   projectID,
+		"cluster_name": clusterName,
+	}))
+
+	return nil
+}
+
+func flattenBackupPolicies(policies []*backupPolicy) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0, len(policies))
+	for _, policy := range policies {
+		items := make([]map[string]interface{}, 0, len(policy.PolicyItems))
+		for _, item := range policy.PolicyItems {
+			items = append(items, map[string]interface{}{
+				"id":                 item.ID,
+				"frequency_type":     item.FrequencyType,
+				"frequency_interval": item.FrequencyInterval,
+				"retention_unit":     item.RetentionUnit,
+				"retention_value":    item.RetentionValue,
+			})
+		}
+		results = append(results, map[string]interface{}{
+			"id":           policy.ID,
+			"policy_items": items,
+		})
+	}
+	return results
+}
@@ -0,0 +1,101 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+func TestAccDataSourceMongoDBAtlasCloudProviderSnapshotBackupPolicy_basic(t *testing.T) {
+	dataSourceName := "data.mongodbatlas_cloud_provider_snapshot_backup_policy.test"
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+	clusterName := os.Getenv("MONGODB_ATLAS_CLUSTER_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); checkClusterEnv(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasCloudProviderSnapshotBackupPolicyDSConfig(projectID, clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cluster_name", clusterName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "cluster_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "reference_hour_of_day"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "restore_window_days"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "policies.0.policy_items.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenBackupPolicies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/groups/5d09d6a59ccf6445652a444a/clusters/cluster0/backup/schedule" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{
+			"clusterId": "5e2211c17a3e5a48f5497de3",
+			"clusterName": "cluster0",
+			"referenceHourOfDay": 12,
+			"referenceMinuteOfHour": 16,
+			"restoreWindowDays": 7,
+			"nextSnapshot": "2020-06-22T00:16:24Z",
+			"copySettings": [],
+			"policies": [{
+				"id": "5f0747cad187d8609a72f546",
+				"policyItems": [
+					{"id": "5f0747cad187d8609a72f547", "frequencyType": "hourly", "frequencyInterval": 6, "retentionUnit": "days", "retentionValue": 2},
+					{"id": "5f0747cad187d8609a72f548", "frequencyType": "daily", "frequencyInterval": 1, "retentionUnit": "days", "retentionValue": 7}
+				]
+			}]
+		}`)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	policy, _, err := getCloudProviderSnapshotBackupPolicy(client, "5d09d6a59ccf6445652a444a", "cluster0")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"id": "5f0747cad187d8609a72f546",
+			"policy_items": []map[string]interface{}{
+				{"id": "5f0747cad187d8609a72f547", "frequency_type": "hourly", "frequency_interval": int64(6), "retention_unit": "days", "retention_value": int64(2)},
+				{"id": "5f0747cad187d8609a72f548", "frequency_type": "daily", "frequency_interval": int64(1), "retention_unit": "days", "retention_value": int64(7)},
+			},
+		},
+	}
+	if results := flattenBackupPolicies(policy.Policies); !reflect.DeepEqual(results, expected) {
+		t.Fatalf("expected %v, got %v", expected, results)
+	}
+
+	d := dataSourceMongoDBAtlasCloudProviderSnapshotBackupPolicy().TestResourceData()
+	if err := d.Set("policies", flattenBackupPolicies(policy.Policies)); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if retention := d.Get("policies.0.policy_items.1.retention_value").(int); retention != 7 {
+		t.Fatalf("expected a retention of 7 days, got %d", retention)
+	}
+}
+
+func testAccMongoDBAtlasCloudProviderSnapshotBackupPolicyDSConfig(projectID, clusterName string) string {
+	return fmt.Sprintf(`
+		data "mongodbatlas_cloud_provider_snapshot_backup_policy" "test" {
+			project_id   = "%s"
+			cluster_name = "%s"
+		}
+	`, projectID, clusterName)
+}
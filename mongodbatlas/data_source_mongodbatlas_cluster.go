@@ -1,13 +1,10 @@
 package mongodbatlas
 
 import (
-	"context"
 	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/terraform/helper/schema"
-
-	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
 )
 
 func dataSourceMongoDBAtlasCluster() *schema.Resource {
@@ -174,6 +171,10 @@ func dataSourceMongoDBAtlasCluster() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"termination_protection_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"srv_address": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -188,11 +189,11 @@ func dataSourceMongoDBAtlasCluster() *schema.Resource {
 
 func dataSourceMongoDBAtlasClusterRead(d *schema.ResourceData, meta interface{}) error {
 	//Get client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 	projectID := d.Get("project_id").(string)
 	name := d.Get("name").(string)
 
-	cluster, resp, err := conn.Clusters.Get(context.Background(), projectID, name)
+	cluster, resp, err := getLabeledCluster(conn, projectID, name)
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
 			return nil
@@ -243,7 +244,7 @@ func dataSourceMongoDBAtlasClusterRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf(errorRead, name, err)
 	}
 
-	if err := d.Set("mongo_uri_updated", cluster.MongoURIUpdated); err != nil {
+	if err := d.Set("mongo_uri_updated", normalizeMongoURIUpdated(cluster.MongoURIUpdated)); err != nil {
 		return fmt.Errorf(errorRead, name, err)
 	}
 
@@ -255,6 +256,10 @@ func dataSourceMongoDBAtlasClusterRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf(errorRead, name, err)
 	}
 
+	if err := d.Set("termination_protection_enabled", cluster.TerminationProtectionEnabled); err != nil {
+		return fmt.Errorf(errorRead, name, err)
+	}
+
 	if err := d.Set("srv_address", cluster.SrvAddress); err != nil {
 		return fmt.Errorf(errorRead, name, err)
 	}
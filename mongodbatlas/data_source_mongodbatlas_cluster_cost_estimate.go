@@ -0,0 +1,255 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	// Atlas bills the instances by the hour and the storage by the day.
+	hoursPerMonth = 730
+	daysPerMonth  = 365.0 / 12
+
+	errorClusterCostEstimateRead    = "error estimating the cost of a %s cluster in organization (%s): %s"
+	errorClusterCostEstimateSetting = "error setting `%s` for the cost estimate of a %s cluster: %s"
+)
+
+// clusterCostSpec is the cluster whose cost is estimated.
+type clusterCostSpec struct {
+	ProviderName   string
+	InstanceSize   string
+	RegionName     string
+	ElectableNodes int
+	ReadOnlyNodes  int
+	AnalyticsNodes int
+	NumShards      int
+	DiskSizeGB     float64
+}
+
+// clusterCostEstimate is the estimated monthly cost of a cluster, in US dollars.
+type clusterCostEstimate struct {
+	InstanceHourlyPrice   float64
+	StorageGBMonthlyPrice float64
+	EstimatedMonthlyCost  float64
+	PricingAvailable      bool
+	Warnings              []string
+}
+
+func dataSourceMongoDBAtlasClusterCostEstimate() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMongoDBAtlasClusterCostEstimateRead,
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"provider_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"AWS", "GCP", "AZURE"}, false),
+			},
+			"provider_instance_size_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"region_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"electable_nodes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"read_only_nodes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"analytics_nodes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"num_shards": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"disk_size_gb": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"instance_hourly_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"storage_gb_monthly_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"estimated_monthly_cost": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"currency": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pricing_available": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"warnings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasClusterCostEstimateRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	orgID := d.Get("org_id").(string)
+	spec := clusterCostSpec{
+		ProviderName:   d.Get("provider_name").(string),
+		InstanceSize:   d.Get("provider_instance_size_name").(string),
+		RegionName:     d.Get("region_name").(string),
+		ElectableNodes: d.Get("electable_nodes").(int),
+		ReadOnlyNodes:  d.Get("read_only_nodes").(int),
+		AnalyticsNodes: d.Get("analytics_nodes").(int),
+		NumShards:      d.Get("num_shards").(int),
+		DiskSizeGB:     d.Get("disk_size_gb").(float64),
+	}
+
+	pending, _, err := getInvoice(conn, orgID, pendingInvoiceID)
+	if err != nil {
+		return fmt.Errorf(errorClusterCostEstimateRead, spec.InstanceSize, orgID, err)
+	}
+
+	estimate := estimateClusterCost(spec, pending.LineItems, func(projectID, clusterName string) (string, error) {
+		cluster, _, err := conn.Clusters.Get(context.Background(), projectID, clusterName)
+		if err != nil {
+			return "", err
+		}
+		if cluster.ProviderSettings == nil {
+			return "", nil
+		}
+		return cluster.ProviderSettings.RegionName, nil
+	})
+
+	if err := d.Set("instance_hourly_price", estimate.InstanceHourlyPrice); err != nil {
+		return fmt.Errorf(errorClusterCostEstimateSetting, "instance_hourly_price", spec.InstanceSize, err)
+	}
+	if err := d.Set("storage_gb_monthly_price", estimate.StorageGBMonthlyPrice); err != nil {
+		return fmt.Errorf(errorClusterCostEstimateSetting, "storage_gb_monthly_price", spec.InstanceSize, err)
+	}
+	if err := d.Set("estimated_monthly_cost", estimate.EstimatedMonthlyCost); err != nil {
+		return fmt.Errorf(errorClusterCostEstimateSetting, "estimated_monthly_cost", spec.InstanceSize, err)
+	}
+	if err := d.Set("currency", "USD"); err != nil {
+		return fmt.Errorf(errorClusterCostEstimateSetting, "currency", spec.InstanceSize, err)
+	}
+	if err := d.Set("pricing_available", estimate.PricingAvailable); err != nil {
+		return fmt.Errorf(errorClusterCostEstimateSetting, "pricing_available", spec.InstanceSize, err)
+	}
+	if err := d.Set("warnings", estimate.Warnings); err != nil {
+		return fmt.Errorf(errorClusterCostEstimateSetting, "warnings", spec.InstanceSize, err)
+	}
+
+	d.SetId(resource.UniqueId())
+
+	return nil
+}
+
+// estimateClusterCost estimates the monthly cost of the cluster from the unit prices billed to
+// the organization, since Atlas doesn't publish its pricing. A price billed for a cluster in
+// the same region is preferred, the region of a cluster is only requested when the prices of
+// its instance size differ. The estimate is 0 and pricing isn't available if the organization
+// isn't billed for the instance size.
+func estimateClusterCost(spec clusterCostSpec, lineItems []*invoiceLineItem, clusterRegion func(projectID, clusterName string) (string, error)) *clusterCostEstimate {
+	estimate := &clusterCostEstimate{Warnings: []string{}}
+
+	instanceSKU := fmt.Sprintf("ATLAS_%s_INSTANCE_%s", spec.ProviderName, spec.InstanceSize)
+	storageSKU := fmt.Sprintf("ATLAS_%s_STORAGE_", spec.ProviderName)
+
+	var instanceItems, storageItems []*invoiceLineItem
+	for _, item := range lineItems {
+		switch {
+		case item.UnitPriceDollars <= 0:
+		case item.SKU == instanceSKU:
+			instanceItems = append(instanceItems, item)
+		case strings.HasPrefix(item.SKU, storageSKU) && strings.HasPrefix(item.Unit, "GB"):
+			storageItems = append(storageItems, item)
+		}
+	}
+
+	if len(instanceItems) == 0 {
+		estimate.Warnings = append(estimate.Warnings, fmt.Sprintf("the organization isn't billed for %s, the price of a %s %s instance isn't available", instanceSKU, spec.ProviderName, spec.InstanceSize))
+		return estimate
+	}
+
+	instanceItem, sameRegion := regionLineItem(instanceItems, spec.RegionName, clusterRegion)
+	if !sameRegion {
+		estimate.Warnings = append(estimate.Warnings, fmt.Sprintf("the price of %s is billed for a cluster in another region than %s", instanceSKU, spec.RegionName))
+	}
+	estimate.PricingAvailable = true
+	estimate.InstanceHourlyPrice = instanceItem.UnitPriceDollars
+
+	nodes := (spec.ElectableNodes + spec.ReadOnlyNodes + spec.AnalyticsNodes) * spec.NumShards
+	estimate.EstimatedMonthlyCost = instanceItem.UnitPriceDollars * hoursPerMonth * float64(nodes)
+
+	if spec.DiskSizeGB > 0 {
+		if len(storageItems) == 0 {
+			estimate.Warnings = append(estimate.Warnings, fmt.Sprintf("the organization isn't billed for the storage of %s clusters, the disk isn't included", spec.ProviderName))
+		} else {
+			storageItem, _ := regionLineItem(storageItems, spec.RegionName, clusterRegion)
+			estimate.StorageGBMonthlyPrice = storageItem.UnitPriceDollars * daysPerMonth
+			estimate.EstimatedMonthlyCost += estimate.StorageGBMonthlyPrice * spec.DiskSizeGB * float64(nodes)
+		}
+	}
+
+	if spec.NumShards > 1 {
+		estimate.Warnings = append(estimate.Warnings, "the config servers and mongos of the sharded cluster aren't included")
+	}
+	estimate.Warnings = append(estimate.Warnings, "the backup, data transfer and support costs aren't included")
+
+	return estimate
+}
+
+// regionLineItem returns the line item of a cluster in the region, and false if there's none and
+// the first one is returned instead. The items are used as is when they all have the same price.
+func regionLineItem(items []*invoiceLineItem, regionName string, clusterRegion func(projectID, clusterName string) (string, error)) (*invoiceLineItem, bool) {
+	samePrice := true
+	for _, item := range items[1:] {
+		if item.UnitPriceDollars != items[0].UnitPriceDollars {
+			samePrice = false
+			break
+		}
+	}
+	if samePrice {
+		return items[0], true
+	}
+
+	for _, item := range items {
+		if item.GroupID == "" || item.ClusterName == "" {
+			continue
+		}
+		region, err := clusterRegion(item.GroupID, item.ClusterName)
+		if err == nil && region == regionName {
+			return item, true
+		}
+	}
+	return items[0], false
+}
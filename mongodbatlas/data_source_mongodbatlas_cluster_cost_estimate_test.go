@@ -0,0 +1,97 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+func TestDataSourceMongoDBAtlasClusterCostEstimateRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/orgs/5b71ff2f96e82120d0aaec14/invoices/pending":
+			fmt.Fprint(w, `{
+				"id": "5c1d2b4e9ccf6401f3bd0e29",
+				"orgId": "5b71ff2f96e82120d0aaec14",
+				"lineItems": [
+					{"clusterName": "eu", "groupId": "5d09d6a59ccf6445652a444a", "sku": "ATLAS_AWS_INSTANCE_M10", "unit": "server hours", "unitPriceDollars": 0.09},
+					{"clusterName": "us", "groupId": "5d09d6a59ccf6445652a444a", "sku": "ATLAS_AWS_INSTANCE_M10", "unit": "server hours", "unitPriceDollars": 0.08},
+					{"clusterName": "us", "groupId": "5d09d6a59ccf6445652a444a", "sku": "ATLAS_AWS_STORAGE_PROVISIONED", "unit": "GB days", "unitPriceDollars": 0.004},
+					{"clusterName": "us", "groupId": "5d09d6a59ccf6445652a444a", "sku": "ATLAS_AWS_DATA_TRANSFER_SAME_REGION", "unit": "GB", "unitPriceDollars": 0.01}
+				]
+			}`)
+		case "/groups/5d09d6a59ccf6445652a444a/clusters/eu":
+			fmt.Fprint(w, `{"name": "eu", "providerSettings": {"providerName": "AWS", "regionName": "EU_WEST_1"}}`)
+		case "/groups/5d09d6a59ccf6445652a444a/clusters/us":
+			fmt.Fprint(w, `{"name": "us", "providerSettings": {"providerName": "AWS", "regionName": "US_EAST_1"}}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceMongoDBAtlasClusterCostEstimate().Schema, map[string]interface{}{
+		"org_id":                      "5b71ff2f96e82120d0aaec14",
+		"provider_name":               "AWS",
+		"provider_instance_size_name": "M10",
+		"region_name":                 "US_EAST_1",
+		"disk_size_gb":                10.0,
+	})
+	if err := dataSourceMongoDBAtlasClusterCostEstimateRead(d, &MongoDBClient{Atlas: client}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if !d.Get("pricing_available").(bool) {
+		t.Fatal("expected the pricing to be available")
+	}
+	if price := d.Get("instance_hourly_price").(float64); price != 0.08 {
+		t.Fatalf("expected the price of the cluster in the region, got %v", price)
+	}
+	// 3 nodes of 730 hours, with 10 GB each.
+	expected := 3*730*0.08 + 3*10*0.004*365/12
+	if cost := d.Get("estimated_monthly_cost").(float64); math.Abs(cost-expected) > 0.001 {
+		t.Fatalf("expected an estimate of %v, got %v", expected, cost)
+	}
+	if currency := d.Get("currency").(string); currency != "USD" {
+		t.Fatalf("expected USD, got %s", currency)
+	}
+}
+
+func TestEstimateClusterCost(t *testing.T) {
+	noRegion := func(projectID, clusterName string) (string, error) {
+		t.Fatalf("unexpected region request for cluster %s", clusterName)
+		return "", nil
+	}
+	lineItems := []*invoiceLineItem{
+		{ClusterName: "c0", GroupID: "p0", SKU: "ATLAS_GCP_INSTANCE_M30", Unit: "server hours", UnitPriceDollars: 0.6},
+		{ClusterName: "c1", GroupID: "p0", SKU: "ATLAS_GCP_INSTANCE_M30", Unit: "server hours", UnitPriceDollars: 0.6},
+	}
+
+	estimate := estimateClusterCost(clusterCostSpec{ProviderName: "GCP", InstanceSize: "M30", RegionName: "CENTRAL_US", ElectableNodes: 3, AnalyticsNodes: 1, NumShards: 2}, lineItems, noRegion)
+	if !estimate.PricingAvailable || math.Abs(estimate.EstimatedMonthlyCost-8*730*0.6) > 0.001 {
+		t.Fatalf("unexpected estimate %+v", estimate)
+	}
+	if len(estimate.Warnings) != 2 {
+		t.Fatalf("expected warnings for the sharded cluster and the costs not included, got %v", estimate.Warnings)
+	}
+
+	estimate = estimateClusterCost(clusterCostSpec{ProviderName: "GCP", InstanceSize: "M30", RegionName: "CENTRAL_US", ElectableNodes: 3, NumShards: 1, DiskSizeGB: 40}, lineItems, noRegion)
+	if !estimate.PricingAvailable || estimate.StorageGBMonthlyPrice != 0 || math.Abs(estimate.EstimatedMonthlyCost-3*730*0.6) > 0.001 {
+		t.Fatalf("expected the estimate without the storage, got %+v", estimate)
+	}
+
+	estimate = estimateClusterCost(clusterCostSpec{ProviderName: "AZURE", InstanceSize: "M30", RegionName: "US_EAST_2", ElectableNodes: 3, NumShards: 1}, lineItems, noRegion)
+	if estimate.PricingAvailable || estimate.EstimatedMonthlyCost != 0 || len(estimate.Warnings) != 1 {
+		t.Fatalf("expected the pricing not to be available, got %+v", estimate)
+	}
+}
@@ -0,0 +1,163 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+const (
+	clusterLogsPath         = "groups/%s/clusters/%s/logs/%s"
+	errorClusterLogsRead    = "error downloading %s of host (%s): %s"
+	errorClusterLogsSetting = "error setting `%s` for %s of host (%s): %s"
+)
+
+// clusterLogNames are the compressed logs of a process that can be downloaded.
+// See more: https://docs.atlas.mongodb.com/reference/api/logs/
+var clusterLogNames = []string{
+	"mongodb.gz",
+	"mongos.gz",
+	"mongodb-audit-log.gz",
+	"mongos-audit-log.gz",
+}
+
+func dataSourceMongoDBAtlasClusterLogs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMongoDBAtlasClusterLogsRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"hostname": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"log_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(clusterLogNames, false),
+			},
+			"start_date": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"end_date": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"output_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"file_path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasClusterLogsRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+	hostname := d.Get("hostname").(string)
+	logName := d.Get("log_name").(string)
+	startDate := d.Get("start_date").(int)
+	endDate := d.Get("end_date").(int)
+
+	if startDate > 0 && endDate > 0 && endDate <= startDate {
+		return fmt.Errorf(errorClusterLogsRead, logName, hostname, fmt.Errorf("end_date (%d) must be after start_date (%d)", endDate, startDate))
+	}
+
+	outputPath := fmt.Sprintf("%s_%s", hostname, logName)
+	if v, ok := d.GetOk("output_path"); ok {
+		outputPath = v.(string)
+	}
+	filePath, err := filepath.Abs(outputPath)
+	if err != nil {
+		return fmt.Errorf(errorClusterLogsRead, logName, hostname, err)
+	}
+
+	size, err := downloadClusterLogs(conn, projectID, hostname, logName, startDate, endDate, filePath)
+	if err != nil {
+		return fmt.Errorf(errorClusterLogsRead, logName, hostname, err)
+	}
+
+	if err := d.Set("file_path", filePath); err != nil {
+		return fmt.Errorf(errorClusterLogsSetting, "file_path", logName, hostname, err)
+	}
+	if err := d.Set("size", size); err != nil {
+		return fmt.Errorf(errorClusterLogsSetting, "size", logName, hostname, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id": projectID,
+		"hostname":   hostname,
+		"log_name":   logName,
+	}))
+
+	return nil
+}
+
+// downloadClusterLogs writes the compressed logs of the process to the file and returns their
+// size in bytes. The logs are streamed to a temporary file next to it, which replaces the file
+// once the download completes, so a failed download doesn't leave a truncated file behind.
+func downloadClusterLogs(conn *matlas.Client, projectID, hostname, logName string, startDate, endDate int, filePath string) (int64, error) {
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, fmt.Sprintf(clusterLogsPath, projectID, url.PathEscape(hostname), logName), nil)
+	if err != nil {
+		return 0, err
+	}
+
+	query := req.URL.Query()
+	if startDate > 0 {
+		query.Set("startDate", strconv.Itoa(startDate))
+	}
+	if endDate > 0 {
+		query.Set("endDate", strconv.Itoa(endDate))
+	}
+	req.URL.RawQuery = query.Encode()
+	req.Header.Set("Accept", "application/gzip")
+
+	file, err := ioutil.TempFile(filepath.Dir(filePath), filepath.Base(filePath)+".*")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := conn.Do(context.Background(), req, file); err != nil {
+		file.Close()
+		return 0, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return 0, err
+	}
+	if err := file.Close(); err != nil {
+		return 0, err
+	}
+
+	if err := os.Rename(file.Name(), filePath); err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
@@ -0,0 +1,97 @@
+package mongodbatlas
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+func TestDownloadClusterLogs(t *testing.T) {
+	var logs bytes.Buffer
+	gz := gzip.NewWriter(&logs)
+	fmt.Fprint(gz, `{"t":{"$date":"2020-10-15T00:00:00.000Z"},"s":"I","c":"NETWORK","msg":"Connection accepted"}`)
+	if err := gz.Close(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RequestURI())
+		if r.Header.Get("Accept") != "application/gzip" {
+			t.Errorf("expected to accept application/gzip, got %s", r.Header.Get("Accept"))
+		}
+		w.Header().Set("Content-Type", "application/gzip")
+		_, _ = w.Write(logs.Bytes())
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	dir, err := ioutil.TempDir("", "cluster-logs")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	filePath := filepath.Join(dir, "mongodb.gz")
+	size, err := downloadClusterLogs(client, "5d09d6a59ccf6445652a444a", "cluster0-shard-00-00.abcde.mongodb.net", "mongodb.gz", 1602720000, 1602806400, filePath)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := "/groups/5d09d6a59ccf6445652a444a/clusters/cluster0-shard-00-00.abcde.mongodb.net/logs/mongodb.gz?endDate=1602806400&startDate=1602720000"
+	if len(requests) != 1 || requests[0] != expected {
+		t.Fatalf("expected request %s, got %v", expected, requests)
+	}
+	if size != int64(logs.Len()) {
+		t.Fatalf("expected size %d, got %d", logs.Len(), size)
+	}
+	content, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !bytes.Equal(content, logs.Bytes()) {
+		t.Fatal("expected the file to contain the downloaded logs")
+	}
+
+	// A failed download keeps the previous file and doesn't leave a temporary one behind.
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"detail": "No host with name unknown exists.", "error": 404, "errorCode": "HOST_NOT_FOUND", "reason": "Not Found"}`)
+	}))
+	defer failing.Close()
+
+	failingClient, err := matlas.New(nil, matlas.SetBaseURL(failing.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := downloadClusterLogs(failingClient, "5d09d6a59ccf6445652a444a", "unknown", "mongodb.gz", 0, 0, filePath); err == nil {
+		t.Fatal("expected the error of the request")
+	}
+
+	content, err = ioutil.ReadFile(filePath)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !bytes.Equal(content, logs.Bytes()) {
+		t.Fatal("expected the failed download to keep the previous file")
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("expected only the downloaded file, got %d files", len(files))
+	}
+}
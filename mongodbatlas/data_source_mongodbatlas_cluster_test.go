@@ -2,6 +2,8 @@ package mongodbatlas
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
@@ -40,6 +42,7 @@ func TestAccDataSourceMongoDBAtlasCluster_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(dataSourceName, "mongo_uri"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "replication_specs.#"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "replication_specs.0.regions_config.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "termination_protection_enabled", "false"),
 				),
 			},
 		},
@@ -47,6 +50,29 @@ func TestAccDataSourceMongoDBAtlasCluster_basic(t *testing.T) {
 
 }
 
+func TestGetLabeledCluster(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/groups/5d09d6a59ccf6445652a444a/clusters/cluster0" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"name": "cluster0", "paused": false, "terminationProtectionEnabled": true, "labels": [{"key": "env", "value": "prod"}]}`)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	cluster, _, err := getLabeledCluster(client, "5d09d6a59ccf6445652a444a", "cluster0")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if cluster.Name != "cluster0" || cluster.TerminationProtectionEnabled == nil || !*cluster.TerminationProtectionEnabled {
+		t.Fatalf("expected the termination protection of cluster0 to be enabled, got %+v", cluster)
+	}
+}
+
 func testAccDataSourceMongoDBAtlasClusterConfig(projectID, name, backupEnabled string) string {
 	return fmt.Sprintf(`
 		resource "mongodbatlas_cluster" "test" {
@@ -4,13 +4,33 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 
+	"github.com/spf13/cast"
+
 	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
 )
 
+const clustersPath = "groups/%s/clusters"
+
+// clusterLabel is a key-value pair tagging a cluster.
+// See more: https://docs.atlas.mongodb.com/reference/api/clusters-create-one/#request-body-parameters
+type clusterLabel struct {
+	Key   string `json:"key,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// labeledCluster adds the labels and the termination protection, not supported by the client yet,
+// to the cluster.
+type labeledCluster struct {
+	matlas.Cluster
+	Labels                       []clusterLabel `json:"labels,omitempty"`
+	TerminationProtectionEnabled *bool          `json:"terminationProtectionEnabled,omitempty"`
+}
+
 func dataSourceMongoDBAtlasClusters() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceMongoDBAtlasClustersRead,
@@ -19,6 +39,15 @@ func dataSourceMongoDBAtlasClusters() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"paused": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"results": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -188,6 +217,15 @@ func dataSourceMongoDBAtlasClusters() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"termination_protection_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -197,10 +235,10 @@ func dataSourceMongoDBAtlasClusters() *schema.Resource {
 
 func dataSourceMongoDBAtlasClustersRead(d *schema.ResourceData, meta interface{}) error {
 	//Get client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 	projectID := d.Get("project_id").(string)
 
-	clusters, resp, err := conn.Clusters.List(context.Background(), projectID, nil)
+	clusters, resp, err := listLabeledClusters(conn, projectID)
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
 			return nil
@@ -208,6 +246,11 @@ func dataSourceMongoDBAtlasClustersRead(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("error reading cluster list for project(%s): %s", projectID, err)
 	}
 
+	clusters = filterClustersByLabels(clusters, d.Get("labels").(map[string]interface{}))
+	if v, ok := d.GetOkExists("paused"); ok {
+		clusters = filterClustersByPaused(clusters, v.(bool))
+	}
+
 	if err := d.Set("results", flattenClusters(clusters)); err != nil {
 		return fmt.Errorf("error setting cluster list %s", err)
 	}
@@ -217,39 +260,120 @@ func dataSourceMongoDBAtlasClustersRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func flattenClusters(clusters []matlas.Cluster) []map[string]interface{} {
+func listLabeledClusters(conn *matlas.Client, projectID string) ([]labeledCluster, *matlas.Response, error) {
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, fmt.Sprintf(clustersPath, projectID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		Results []labeledCluster `json:"results"`
+	})
+	resp, err := conn.Do(context.Background(), req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root.Results, resp, nil
+}
+
+func getLabeledCluster(conn *matlas.Client, projectID, name string) (*labeledCluster, *matlas.Response, error) {
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, fmt.Sprintf(clustersPath+"/%s", projectID, url.PathEscape(name)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(labeledCluster)
+	resp, err := conn.Do(context.Background(), req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
+
+// filterClustersByLabels keeps the clusters carrying all the labels, the filtering is done
+// client-side since Atlas can't filter the clusters of a project.
+func filterClustersByLabels(clusters []labeledCluster, labels map[string]interface{}) []labeledCluster {
+	if len(labels) == 0 {
+		return clusters
+	}
+
+	filtered := make([]labeledCluster, 0, len(clusters))
+	for _, cluster := range clusters {
+		// A key may be set several times, with different values.
+		clusterLabels := make(map[clusterLabel]bool, len(cluster.Labels))
+		for _, label := range cluster.Labels {
+			clusterLabels[label] = true
+		}
+
+		matches := true
+		for k, v := range labels {
+			if !clusterLabels[clusterLabel{Key: k, Value: cast.ToString(v)}] {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			filtered = append(filtered, cluster)
+		}
+	}
+	return filtered
+}
+
+func flattenClusterLabels(labels []clusterLabel) map[string]interface{} {
+	result := make(map[string]interface{}, len(labels))
+	for _, label := range labels {
+		result[label.Key] = label.Value
+	}
+	return result
+}
+
+func flattenClusters(clusters []labeledCluster) []map[string]interface{} {
 	results := make([]map[string]interface{}, 0)
 
 	for _, cluster := range clusters {
 		result := map[string]interface{}{
-			"auto_scaling_disk_gb_enabled": cluster.BackupEnabled,
-			"backup_enabled":               cluster.BackupEnabled,
-			"provider_backup_enabled":      cluster.ProviderBackupEnabled,
-			"cluster_type":                 cluster.ClusterType,
-			"disk_size_gb":                 cluster.DiskSizeGB,
-			"encryption_at_rest_provider":  cluster.EncryptionAtRestProvider,
-			"mongo_db_major_version":       cluster.MongoDBMajorVersion,
-			"name":                         cluster.Name,
-			"num_shards":                   cluster.NumShards,
-			"mongo_db_version":             cluster.MongoDBVersion,
-			"mongo_uri":                    cluster.MongoURI,
-			"mongo_uri_updated":            cluster.MongoURIUpdated,
-			"mongo_uri_with_options":       cluster.MongoURIWithOptions,
-			"paused":                       cluster.Paused,
-			"srv_address":                  cluster.SrvAddress,
-			"state_name":                   cluster.StateName,
-			"replication_factor":           cluster.ReplicationFactor,
-			"backing_provider_name":        cluster.ProviderSettings.BackingProviderName,
-			"provider_disk_iops":           cluster.ProviderSettings.DiskIOPS,
-			"provider_disk_type_name":      cluster.ProviderSettings.DiskTypeName,
-			"provider_encrypt_ebs_volume":  cluster.ProviderSettings.EncryptEBSVolume,
-			"provider_instance_size_name":  cluster.ProviderSettings.InstanceSizeName,
-			"provider_name":                cluster.ProviderSettings.ProviderName,
-			"provider_region_name":         cluster.ProviderSettings.RegionName,
-			"bi_connector":                 flattenBiConnector(cluster.BiConnector),
-			"replication_specs":            flattenReplicationSpecs(cluster.ReplicationSpecs),
+			"auto_scaling_disk_gb_enabled":   cluster.BackupEnabled,
+			"backup_enabled":                 cluster.BackupEnabled,
+			"provider_backup_enabled":        cluster.ProviderBackupEnabled,
+			"cluster_type":                   cluster.ClusterType,
+			"disk_size_gb":                   cluster.DiskSizeGB,
+			"encryption_at_rest_provider":    cluster.EncryptionAtRestProvider,
+			"mongo_db_major_version":         cluster.MongoDBMajorVersion,
+			"name":                           cluster.Name,
+			"num_shards":                     cluster.NumShards,
+			"mongo_db_version":               cluster.MongoDBVersion,
+			"mongo_uri":                      cluster.MongoURI,
+			"mongo_uri_updated":              normalizeMongoURIUpdated(cluster.MongoURIUpdated),
+			"mongo_uri_with_options":         cluster.MongoURIWithOptions,
+			"paused":                         cluster.Paused,
+			"srv_address":                    cluster.SrvAddress,
+			"state_name":                     cluster.StateName,
+			"replication_factor":             cluster.ReplicationFactor,
+			"backing_provider_name":          cluster.ProviderSettings.BackingProviderName,
+			"provider_disk_iops":             cluster.ProviderSettings.DiskIOPS,
+			"provider_disk_type_name":        cluster.ProviderSettings.DiskTypeName,
+			"provider_encrypt_ebs_volume":    cluster.ProviderSettings.EncryptEBSVolume,
+			"provider_instance_size_name":    cluster.ProviderSettings.InstanceSizeName,
+			"provider_name":                  cluster.ProviderSettings.ProviderName,
+			"provider_region_name":           cluster.ProviderSettings.RegionName,
+			"bi_connector":                   flattenBiConnector(cluster.BiConnector),
+			"replication_specs":              flattenReplicationSpecs(cluster.ReplicationSpecs),
+			"labels":                         flattenClusterLabels(cluster.Labels),
+			"termination_protection_enabled": cluster.TerminationProtectionEnabled,
 		}
 		results = append(results, result)
 	}
 	return results
 }
+
+// filterClustersByPaused keeps the clusters that are paused, or only the active ones, the
+// filtering is done client-side since Atlas can't filter the clusters of a project.
+func filterClustersByPaused(clusters []labeledCluster, paused bool) []labeledCluster {
+	filtered := make([]labeledCluster, 0, len(clusters))
+	for _, cluster := range clusters {
+		if cast.ToBool(cluster.Paused) == paused {
+			filtered = append(filtered, cluster)
+		}
+	}
+	return filtered
+}
@@ -2,7 +2,10 @@ package mongodbatlas
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -45,6 +48,79 @@ func TestAccDataSourceMongoDBAtlasClusters_basic(t *testing.T) {
 
 }
 
+func TestFilterClustersByLabels(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"results": [
+			{"name": "prod-0", "providerSettings": {"providerName": "AWS"}, "labels": [{"key": "environment", "value": "prod"}, {"key": "team", "value": "payments"}]},
+			{"name": "prod-1", "labels": [{"key": "environment", "value": "staging"}, {"key": "environment", "value": "prod"}]},
+			{"name": "dev-0", "labels": [{"key": "environment", "value": "dev"}, {"key": "team", "value": "payments"}]},
+			{"name": "unlabeled"}
+		]}`)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	clusters, _, err := listLabeledClusters(client, "5d09d6a59ccf6445652a444a")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	cases := []struct {
+		labels   map[string]interface{}
+		expected []string
+	}{
+		{labels: nil, expected: []string{"prod-0", "prod-1", "dev-0", "unlabeled"}},
+		{labels: map[string]interface{}{"environment": "prod"}, expected: []string{"prod-0", "prod-1"}},
+		{labels: map[string]interface{}{"environment": "prod", "team": "payments"}, expected: []string{"prod-0"}},
+		{labels: map[string]interface{}{"team": "search"}, expected: []string{}},
+	}
+
+	for _, c := range cases {
+		names := []string{}
+		for _, cluster := range filterClustersByLabels(clusters, c.labels) {
+			names = append(names, cluster.Name)
+		}
+		if !reflect.DeepEqual(names, c.expected) {
+			t.Fatalf("expected clusters %v for labels %v, got %v", c.expected, c.labels, names)
+		}
+	}
+
+	if labels := flattenClusters(clusters[:1])[0]["labels"]; !reflect.DeepEqual(labels, map[string]interface{}{"environment": "prod", "team": "payments"}) {
+		t.Fatalf("unexpected labels %v", labels)
+	}
+}
+
+func TestFilterClustersByPaused(t *testing.T) {
+	paused, active := true, false
+	clusters := []labeledCluster{
+		{Cluster: matlas.Cluster{Name: "paused", Paused: &paused}},
+		{Cluster: matlas.Cluster{Name: "active", Paused: &active}},
+		{Cluster: matlas.Cluster{Name: "unknown"}},
+	}
+
+	cases := []struct {
+		paused   bool
+		expected []string
+	}{
+		{paused: true, expected: []string{"paused"}},
+		{paused: false, expected: []string{"active", "unknown"}},
+	}
+
+	for _, c := range cases {
+		names := []string{}
+		for _, cluster := range filterClustersByPaused(clusters, c.paused) {
+			names = append(names, cluster.Name)
+		}
+		if !reflect.DeepEqual(names, c.expected) {
+			t.Fatalf("expected clusters %v for paused %t, got %v", c.expected, c.paused, names)
+		}
+	}
+}
+
 func testAccDataSourceMongoDBAtlasClustersConfig(projectID, name, backupEnabled string) string {
 	return fmt.Sprintf(`
 		resource "mongodbatlas_cluster" "test" {
@@ -0,0 +1,113 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+const (
+	// The control plane IP addresses are only published by the versioned API.
+	controlPlaneIPAddressesPath         = "../v2/unauth/controlPlaneIPAddresses"
+	errorControlPlaneIPAddressesRead    = "error getting control plane IP addresses: %s"
+	errorControlPlaneIPAddressesSetting = "error setting `%s` for control plane IP addresses: %s"
+)
+
+// controlPlaneIPAddresses holds the CIDR blocks of the Atlas control plane, by direction,
+// cloud provider and region.
+// See more: https://www.mongodb.com/docs/atlas/reference/api-resources-spec/v2/#tag/Root/operation/returnAllControlPlaneIPAddresses
+type controlPlaneIPAddresses struct {
+	Inbound  map[string]map[string][]string `json:"inbound,omitempty"`
+	Outbound map[string]map[string][]string `json:"outbound,omitempty"`
+}
+
+func dataSourceMongoDBAtlasControlPlaneIPAddresses() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMongoDBAtlasControlPlaneIPAddressesRead,
+		Schema: map[string]*schema.Schema{
+			"inbound":  controlPlaneIPAddressesSchema(),
+			"outbound": controlPlaneIPAddressesSchema(),
+		},
+	}
+}
+
+func controlPlaneIPAddressesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"provider_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"region_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"cidr_blocks": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasControlPlaneIPAddressesRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+
+	ipAddresses, err := getControlPlaneIPAddresses(conn)
+	if err != nil {
+		return fmt.Errorf(errorControlPlaneIPAddressesRead, err)
+	}
+
+	if err := d.Set("inbound", flattenControlPlaneIPAddresses(ipAddresses.Inbound)); err != nil {
+		return fmt.Errorf(errorControlPlaneIPAddressesSetting, "inbound", err)
+	}
+	if err := d.Set("outbound", flattenControlPlaneIPAddresses(ipAddresses.Outbound)); err != nil {
+		return fmt.Errorf(errorControlPlaneIPAddressesSetting, "outbound", err)
+	}
+
+	d.SetId(resource.UniqueId())
+
+	return nil
+}
+
+func getControlPlaneIPAddresses(conn *matlas.Client) (*controlPlaneIPAddresses, error) {
+	root := new(controlPlaneIPAddresses)
+	if _, err := doAtlasV2Request(conn, http.MethodGet, controlPlaneIPAddressesPath, nil, root); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+// flattenControlPlaneIPAddresses returns one element per cloud provider and region, sorted so
+// that the plan doesn't change with the order of the API response.
+func flattenControlPlaneIPAddresses(ipAddresses map[string]map[string][]string) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0)
+	for providerName, regions := range ipAddresses {
+		for regionName, cidrBlocks := range regions {
+			results = append(results, map[string]interface{}{
+				"provider_name": providerName,
+				"region_name":   regionName,
+				"cidr_blocks":   cidrBlocks,
+			})
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i]["provider_name"] != results[j]["provider_name"] {
+			return results[i]["provider_name"].(string) < results[j]["provider_name"].(string)
+		}
+		return results[i]["region_name"].(string) < results[j]["region_name"].(string)
+	})
+	return results
+}
@@ -0,0 +1,83 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+func TestAccDataSourceMongoDBAtlasControlPlaneIPAddresses_basic(t *testing.T) {
+	resourceName := "data.mongodbatlas_control_plane_ip_addresses.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasControlPlaneIPAddressesDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "inbound.#"),
+					resource.TestCheckResourceAttrSet(resourceName, "outbound.#"),
+					resource.TestCheckResourceAttrSet(resourceName, "outbound.0.provider_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "outbound.0.cidr_blocks.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestGetControlPlaneIPAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/atlas/v2/unauth/controlPlaneIPAddresses" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if accept := r.Header.Get("Accept"); accept != atlasV2AcceptHeader {
+			t.Errorf("unexpected Accept header %s", accept)
+		}
+		fmt.Fprint(w, `{
+			"inbound": {"aws": {"us-east-1": ["3.92.113.229/32"]}},
+			"outbound": {
+				"gcp": {"us-central1": ["34.121.0.0/16"]},
+				"aws": {"us-west-2": ["44.230.0.0/16"], "us-east-1": ["3.92.0.0/16", "3.93.0.0/16"]}
+			}
+		}`)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/api/atlas/v1.0/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	ipAddresses, err := getControlPlaneIPAddresses(client)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	outbound := flattenControlPlaneIPAddresses(ipAddresses.Outbound)
+	expected := [][2]string{{"aws", "us-east-1"}, {"aws", "us-west-2"}, {"gcp", "us-central1"}}
+	if len(outbound) != len(expected) {
+		t.Fatalf("expected %d outbound ranges, got %v", len(expected), outbound)
+	}
+	for i, e := range expected {
+		if outbound[i]["provider_name"] != e[0] || outbound[i]["region_name"] != e[1] {
+			t.Fatalf("expected %v at %d, got %v", e, i, outbound[i])
+		}
+	}
+	if cidrBlocks := outbound[0]["cidr_blocks"].([]string); len(cidrBlocks) != 2 {
+		t.Fatalf("expected 2 CIDR blocks in aws us-east-1, got %v", cidrBlocks)
+	}
+	if inbound := flattenControlPlaneIPAddresses(ipAddresses.Inbound); len(inbound) != 1 {
+		t.Fatalf("expected 1 inbound range, got %v", inbound)
+	}
+}
+
+func testAccMongoDBAtlasControlPlaneIPAddressesDataSourceConfig() string {
+	return `
+		data "mongodbatlas_control_plane_ip_addresses" "test" {}
+	`
+}
@@ -5,8 +5,6 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform/helper/schema"
-
-	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
 )
 
 func dataSourceMongoDBAtlasDatabaseUser() *schema.Resource {
@@ -52,7 +50,7 @@ func dataSourceMongoDBAtlasDatabaseUser() *schema.Resource {
 
 func dataSourceMongoDBAtlasDatabaseUserRead(d *schema.ResourceData, meta interface{}) error {
 	//Get client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 	projectID := d.Get("project_id").(string)
 	username := d.Get("username").(string)
 
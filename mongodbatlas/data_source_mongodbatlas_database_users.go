@@ -69,7 +69,7 @@ func dataSourceMongoDBAtlasDatabaseUsers() *schema.Resource {
 
 func dataSourceMongoDBAtlasDatabaseUsersRead(d *schema.ResourceData, meta interface{}) error {
 	//Get client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 
 	projectID := d.Get("project_id").(string)
 
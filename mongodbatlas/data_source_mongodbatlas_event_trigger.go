@@ -0,0 +1,177 @@
+package mongodbatlas
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const realmEventTriggersPath = "groups/%s/apps/%s/triggers"
+
+// eventTrigger represents a trigger of a Realm application.
+// See more: https://docs.mongodb.com/realm/admin/api/v3/#triggers-apis
+type eventTrigger struct {
+	ID           string              `json:"_id,omitempty"`
+	Name         string              `json:"name,omitempty"`
+	Type         string              `json:"type,omitempty"`
+	FunctionID   string              `json:"function_id,omitempty"`
+	FunctionName string              `json:"function_name,omitempty"`
+	Disabled     bool                `json:"disabled"`
+	Config       *eventTriggerConfig `json:"config,omitempty"`
+}
+
+type eventTriggerConfig struct {
+	OperationTypes []string               `json:"operation_types,omitempty"`
+	OperationType  string                 `json:"operation_type,omitempty"`
+	Providers      []string               `json:"providers,omitempty"`
+	Database       string                 `json:"database,omitempty"`
+	Collection     string                 `json:"collection,omitempty"`
+	ServiceID      string                 `json:"service_id,omitempty"`
+	Match          map[string]interface{} `json:"match,omitempty"`
+	FullDocument   bool                   `json:"full_document"`
+	Schedule       string                 `json:"schedule,omitempty"`
+}
+
+func dataSourceMongoDBAtlasEventTrigger() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceMongoDBAtlasEventTriggerRead,
+		Schema: eventTriggerSchema(),
+	}
+}
+
+// eventTriggerSchema returns the computed attributes of a trigger, shared with the plural data source.
+func eventTriggerSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project_id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"app_id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"trigger_id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"function_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"function_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"disabled": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"config_operation_types": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"config_operation_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"config_providers": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"config_database": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"config_collection": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"config_service_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"config_match": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"config_full_document": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"config_schedule": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasEventTriggerRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Realm
+	projectID := d.Get("project_id").(string)
+	appID := d.Get("app_id").(string)
+	triggerID := d.Get("trigger_id").(string)
+
+	trigger := new(eventTrigger)
+	if err := conn.Get(fmt.Sprintf(realmEventTriggersPath+"/%s", projectID, appID, triggerID), trigger); err != nil {
+		return fmt.Errorf("error getting event trigger (%s) of realm app (%s): %s", triggerID, appID, err)
+	}
+
+	for k, v := range flattenEventTrigger(trigger) {
+		if k == "trigger_id" {
+			continue
+		}
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("error setting `%s` for event trigger (%s): %s", k, triggerID, err)
+		}
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id": projectID,
+		"app_id":     appID,
+		"trigger_id": triggerID,
+	}))
+
+	return nil
+}
+
+func flattenEventTrigger(trigger *eventTrigger) map[string]interface{} {
+	result := map[string]interface{}{
+		"trigger_id":    trigger.ID,
+		"name":          trigger.Name,
+		"type":          trigger.Type,
+		"function_id":   trigger.FunctionID,
+		"function_name": trigger.FunctionName,
+		"disabled":      trigger.Disabled,
+	}
+
+	if config := trigger.Config; config != nil {
+		result["config_operation_types"] = config.OperationTypes
+		result["config_operation_type"] = config.OperationType
+		result["config_providers"] = config.Providers
+		result["config_database"] = config.Database
+		result["config_collection"] = config.Collection
+		result["config_service_id"] = config.ServiceID
+		result["config_full_document"] = config.FullDocument
+		result["config_schedule"] = config.Schedule
+
+		if len(config.Match) > 0 {
+			if match, err := json.Marshal(config.Match); err == nil {
+				result["config_match"] = string(match)
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,43 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceMongoDBAtlasEventTrigger_basic(t *testing.T) {
+	resourceName := "data.mongodbatlas_event_trigger.test"
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+	appName := os.Getenv("MONGODB_REALM_APP_NAME")
+	triggerID := os.Getenv("MONGODB_REALM_TRIGGER_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); checkRealmEnv(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasEventTriggerDataSourceConfig(projectID, appName, triggerID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "trigger_id", triggerID),
+					resource.TestCheckResourceAttrSet(resourceName, "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMongoDBAtlasEventTriggerDataSourceConfig(projectID, appName, triggerID string) string {
+	return fmt.Sprintf(`
+		%s
+
+		data "mongodbatlas_event_trigger" "test" {
+			project_id = data.mongodbatlas_realm_app.test.project_id
+			app_id     = data.mongodbatlas_realm_app.test.app_id
+			trigger_id = "%s"
+		}
+	`, testAccMongoDBAtlasRealmAppDataSourceConfig(projectID, appName), triggerID)
+}
@@ -0,0 +1,66 @@
+package mongodbatlas
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceMongoDBAtlasEventTriggers() *schema.Resource {
+	triggerSchema := eventTriggerSchema()
+	delete(triggerSchema, "project_id")
+	delete(triggerSchema, "app_id")
+	triggerSchema["trigger_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		Read: dataSourceMongoDBAtlasEventTriggersRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"app_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: triggerSchema,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasEventTriggersRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Realm
+	projectID := d.Get("project_id").(string)
+	appID := d.Get("app_id").(string)
+
+	var triggers []*eventTrigger
+	if err := conn.Get(fmt.Sprintf(realmEventTriggersPath, projectID, appID), &triggers); err != nil {
+		return fmt.Errorf("error getting event triggers of realm app (%s): %s", appID, err)
+	}
+
+	results := make([]map[string]interface{}, 0, len(triggers))
+	for _, trigger := range triggers {
+		results = append(results, flattenEventTrigger(trigger))
+	}
+
+	if err := d.Set("results", results); err != nil {
+		return fmt.Errorf("error setting `results` for event triggers of realm app (%s): %s", appID, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id": projectID,
+		"app_id":     appID,
+	}))
+
+	return nil
+}
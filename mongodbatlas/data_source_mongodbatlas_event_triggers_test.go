@@ -0,0 +1,41 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceMongoDBAtlasEventTriggers_basic(t *testing.T) {
+	resourceName := "data.mongodbatlas_event_triggers.test"
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+	appName := os.Getenv("MONGODB_REALM_APP_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); checkRealmEnv(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasEventTriggersDataSourceConfig(projectID, appName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "results.#"),
+					resource.TestCheckResourceAttrSet(resourceName, "results.0.trigger_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "results.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMongoDBAtlasEventTriggersDataSourceConfig(projectID, appName string) string {
+	return fmt.Sprintf(`
+		%s
+
+		data "mongodbatlas_event_triggers" "test" {
+			project_id = data.mongodbatlas_realm_app.test.project_id
+			app_id     = data.mongodbatlas_realm_app.test.app_id
+		}
+	`, testAccMongoDBAtlasRealmAppDataSourceConfig(projectID, appName))
+}
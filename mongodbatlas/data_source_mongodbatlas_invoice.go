@@ -0,0 +1,219 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+const (
+	invoicesPath = "orgs/%s/invoices"
+	// pendingInvoiceID is the ID of the invoice of the current billing cycle.
+	pendingInvoiceID = "pending"
+
+	errorInvoiceRead    = "error getting invoice (%s) of organization (%s): %s"
+	errorInvoiceSetting = "error setting `%s` for invoice (%s): %s"
+)
+
+// invoice is an invoice of an organization, not supported by the client yet.
+// See more: https://docs.atlas.mongodb.com/reference/api/invoices-get-pending/
+type invoice struct {
+	ID                string             `json:"id,omitempty"`
+	OrgID             string             `json:"orgId,omitempty"`
+	Created           string             `json:"created,omitempty"`
+	StartDate         string             `json:"startDate,omitempty"`
+	EndDate           string             `json:"endDate,omitempty"`
+	StatusName        string             `json:"statusName,omitempty"`
+	AmountBilledCents int64              `json:"amountBilledCents,omitempty"`
+	AmountPaidCents   int64              `json:"amountPaidCents,omitempty"`
+	SubtotalCents     int64              `json:"subtotalCents,omitempty"`
+	LineItems         []*invoiceLineItem `json:"lineItems,omitempty"`
+}
+
+// invoiceLineItem is a charge of an invoice, e.g. the server hours of the instances of a cluster.
+type invoiceLineItem struct {
+	ClusterName      string  `json:"clusterName,omitempty"`
+	GroupID          string  `json:"groupId,omitempty"`
+	SKU              string  `json:"sku,omitempty"`
+	Unit             string  `json:"unit,omitempty"`
+	UnitPriceDollars float64 `json:"unitPriceDollars,omitempty"`
+	Quantity         float64 `json:"quantity,omitempty"`
+	TotalPriceCents  int64   `json:"totalPriceCents,omitempty"`
+	StartDate        string  `json:"startDate,omitempty"`
+	EndDate          string  `json:"endDate,omitempty"`
+}
+
+func dataSourceMongoDBAtlasInvoice() *schema.Resource {
+	fields := invoiceSchema()
+	fields["org_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	fields["invoice_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceMongoDBAtlasInvoiceRead,
+		Schema: fields,
+	}
+}
+
+// invoiceSchema returns the computed attributes of an invoice.
+func invoiceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"invoice_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"created": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"start_date": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"end_date": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"status_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"amount_billed_cents": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"amount_paid_cents": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"subtotal_cents": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"line_items": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"cluster_name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"project_id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"sku": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"unit": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"unit_price_dollars": {
+						Type:     schema.TypeFloat,
+						Computed: true,
+					},
+					"quantity": {
+						Type:     schema.TypeFloat,
+						Computed: true,
+					},
+					"total_price_cents": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"start_date": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"end_date": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasInvoiceRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	orgID := d.Get("org_id").(string)
+	invoiceID := d.Get("invoice_id").(string)
+
+	result, _, err := getInvoice(conn, orgID, invoiceID)
+	if err != nil {
+		return fmt.Errorf(errorInvoiceRead, invoiceID, orgID, err)
+	}
+
+	for key, value := range flattenInvoice(result) {
+		//The pending invoice is read with its alias, which is kept in the configuration.
+		if key == "invoice_id" {
+			continue
+		}
+		if err := d.Set(key, value); err != nil {
+			return fmt.Errorf(errorInvoiceSetting, key, invoiceID, err)
+		}
+	}
+
+	d.SetId(result.ID)
+
+	return nil
+}
+
+func getInvoice(conn *matlas.Client, orgID, invoiceID string) (*invoice, *matlas.Response, error) {
+	path := fmt.Sprintf(invoicesPath+"/%s", url.PathEscape(orgID), url.PathEscape(invoiceID))
+
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(invoice)
+	resp, err := conn.Do(context.Background(), req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
+
+func flattenInvoice(result *invoice) map[string]interface{} {
+	lineItems := make([]map[string]interface{}, 0, len(result.LineItems))
+	for _, item := range result.LineItems {
+		lineItems = append(lineItems, map[string]interface{}{
+			"cluster_name":       item.ClusterName,
+			"project_id":         item.GroupID,
+			"sku":                item.SKU,
+			"unit":               item.Unit,
+			"unit_price_dollars": item.UnitPriceDollars,
+			"quantity":           item.Quantity,
+			"total_price_cents":  item.TotalPriceCents,
+			"start_date":         item.StartDate,
+			"end_date":           item.EndDate,
+		})
+	}
+
+	return map[string]interface{}{
+		"invoice_id":          result.ID,
+		"created":             result.Created,
+		"start_date":          result.StartDate,
+		"end_date":            result.EndDate,
+		"status_name":         result.StatusName,
+		"amount_billed_cents": result.AmountBilledCents,
+		"amount_paid_cents":   result.AmountPaidCents,
+		"subtotal_cents":      result.SubtotalCents,
+		"line_items":          lineItems,
+	}
+}
@@ -0,0 +1,81 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+func TestDataSourceMongoDBAtlasInvoiceRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/orgs/5b71ff2f96e82120d0aaec14/invoices/pending" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{
+			"id": "5c1d2b4e9ccf6401f3bd0e29",
+			"orgId": "5b71ff2f96e82120d0aaec14",
+			"created": "2024-03-01T00:00:00Z",
+			"startDate": "2024-03-01T00:00:00Z",
+			"endDate": "2024-04-01T00:00:00Z",
+			"statusName": "PENDING",
+			"amountBilledCents": 0,
+			"subtotalCents": 17520,
+			"lineItems": [
+				{
+					"clusterName": "cluster0",
+					"groupId": "5d09d6a59ccf6445652a444a",
+					"sku": "ATLAS_AWS_INSTANCE_M10",
+					"unit": "server hours",
+					"unitPriceDollars": 0.08,
+					"quantity": 2190,
+					"totalPriceCents": 17520,
+					"startDate": "2024-03-01T00:00:00Z",
+					"endDate": "2024-03-02T00:00:00Z"
+				}
+			]
+		}`)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceMongoDBAtlasInvoice().Schema, map[string]interface{}{
+		"org_id":     "5b71ff2f96e82120d0aaec14",
+		"invoice_id": "pending",
+	})
+	if err := dataSourceMongoDBAtlasInvoiceRead(d, &MongoDBClient{Atlas: client}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if d.Id() != "5c1d2b4e9ccf6401f3bd0e29" {
+		t.Fatalf("expected the ID of the invoice, got %s", d.Id())
+	}
+	if invoiceID := d.Get("invoice_id").(string); invoiceID != "pending" {
+		t.Fatalf("expected the configured invoice ID to be kept, got %s", invoiceID)
+	}
+
+	expected := map[string]interface{}{
+		"status_name":                     "PENDING",
+		"start_date":                      "2024-03-01T00:00:00Z",
+		"end_date":                        "2024-04-01T00:00:00Z",
+		"subtotal_cents":                  17520,
+		"amount_billed_cents":             0,
+		"line_items.#":                    1,
+		"line_items.0.project_id":         "5d09d6a59ccf6445652a444a",
+		"line_items.0.sku":                "ATLAS_AWS_INSTANCE_M10",
+		"line_items.0.unit_price_dollars": 0.08,
+		"line_items.0.total_price_cents":  17520,
+	}
+	for k, v := range expected {
+		if got := d.Get(k); got != v {
+			t.Fatalf("expected %s to be %v, got %v", k, v, got)
+		}
+	}
+}
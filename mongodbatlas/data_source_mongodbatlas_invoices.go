@@ -0,0 +1,99 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+// invoicesPageSize is the number of invoices requested per page, the maximum allowed by Atlas.
+const invoicesPageSize = 500
+
+func dataSourceMongoDBAtlasInvoices() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMongoDBAtlasInvoicesRead,
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: invoiceSchema(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasInvoicesRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	orgID := d.Get("org_id").(string)
+
+	invoices, err := listInvoices(conn, orgID)
+	if err != nil {
+		return fmt.Errorf("error getting invoices of organization (%s): %s", orgID, err)
+	}
+
+	//The most recent invoices first.
+	sort.SliceStable(invoices, func(i, j int) bool {
+		return invoices[i].StartDate > invoices[j].StartDate
+	})
+	if v, ok := d.GetOk("max_results"); ok && len(invoices) > v.(int) {
+		invoices = invoices[:v.(int)]
+	}
+
+	results := make([]map[string]interface{}, 0, len(invoices))
+	for _, result := range invoices {
+		results = append(results, flattenInvoice(result))
+	}
+	if err := d.Set("results", results); err != nil {
+		return fmt.Errorf("error setting `results` for invoices of organization (%s): %s", orgID, err)
+	}
+
+	d.SetId(resource.UniqueId())
+
+	return nil
+}
+
+// listInvoices returns all the invoices of the organization, requesting all their pages.
+func listInvoices(conn *matlas.Client, orgID string) ([]*invoice, error) {
+	var invoices []*invoice
+	for pageNum := 1; ; pageNum++ {
+		path := fmt.Sprintf(invoicesPath+"?pageNum=%d&itemsPerPage=%d", url.PathEscape(orgID), pageNum, invoicesPageSize)
+
+		req, err := conn.NewRequest(context.Background(), http.MethodGet, path, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		root := new(struct {
+			Results    []*invoice `json:"results"`
+			TotalCount int        `json:"totalCount"`
+		})
+		if _, err := conn.Do(context.Background(), req, root); err != nil {
+			return nil, err
+		}
+
+		invoices = append(invoices, root.Results...)
+		if len(root.Results) < invoicesPageSize || len(invoices) >= root.TotalCount {
+			return invoices, nil
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+func TestDataSourceMongoDBAtlasInvoicesRead(t *testing.T) {
+	var pages []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/orgs/5b71ff2f96e82120d0aaec14/invoices" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		pages = append(pages, r.URL.Query().Get("pageNum"))
+		fmt.Fprint(w, `{
+			"results": [
+				{"id": "january", "startDate": "2024-01-01T00:00:00Z", "statusName": "PAID", "amountBilledCents": 1000},
+				{"id": "march", "startDate": "2024-03-01T00:00:00Z", "statusName": "PENDING"},
+				{"id": "february", "startDate": "2024-02-01T00:00:00Z", "statusName": "CLOSED", "amountBilledCents": 2000}
+			],
+			"totalCount": 3
+		}`)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceMongoDBAtlasInvoices().Schema, map[string]interface{}{
+		"org_id":      "5b71ff2f96e82120d0aaec14",
+		"max_results": 2,
+	})
+	if err := dataSourceMongoDBAtlasInvoicesRead(d, &MongoDBClient{Atlas: client}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if len(pages) != 1 || pages[0] != "1" {
+		t.Fatalf("expected a single page to be requested, got %v", pages)
+	}
+	if count := d.Get("results.#").(int); count != 2 {
+		t.Fatalf("expected the 2 most recent invoices, got %d", count)
+	}
+	if first, second := d.Get("results.0.invoice_id"), d.Get("results.1.invoice_id"); first != "march" || second != "february" {
+		t.Fatalf("expected the most recent invoices first, got %v and %v", first, second)
+	}
+	if billed := d.Get("results.1.amount_billed_cents").(int); billed != 2000 {
+		t.Fatalf("expected 2000 cents billed, got %d", billed)
+	}
+}
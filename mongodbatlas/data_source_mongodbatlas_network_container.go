@@ -6,7 +6,6 @@ import (
 	"net/http"
 
 	"github.com/hashicorp/terraform/helper/schema"
-	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
 )
 
 func dataSourceMongoDBAtlasNetworkContainer() *schema.Resource {
@@ -67,7 +66,7 @@ func dataSourceMongoDBAtlasNetworkContainer() *schema.Resource {
 
 func dataSourceMongoDBAtlasNetworkContainerRead(d *schema.ResourceData, meta interface{}) error {
 	//Get client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 	projectID := d.Get("project_id").(string)
 	containerID := d.Get("container_id").(string)
 
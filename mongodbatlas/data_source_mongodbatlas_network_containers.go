@@ -75,7 +75,7 @@ func dataSourceMongoDBAtlasNetworkContainers() *schema.Resource {
 
 func dataSourceMongoDBAtlasNetworkContainersRead(d *schema.ResourceData, meta interface{}) error {
 	//Get client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 	projectID := d.Get("project_id").(string)
 
 	containers, _, err := conn.Containers.List(context.Background(), projectID, nil)
@@ -4,10 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
-
-	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
 )
 
 func dataSourceMongoDBAtlasNetworkPeering() *schema.Resource {
@@ -22,6 +21,11 @@ func dataSourceMongoDBAtlasNetworkPeering() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"wait_for_available": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"container_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -63,6 +67,10 @@ func dataSourceMongoDBAtlasNetworkPeering() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"accepter_required": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 
 			"atlas_cidr_block": {
 				Type:     schema.TypeString,
@@ -110,10 +118,16 @@ func dataSourceMongoDBAtlasNetworkPeering() *schema.Resource {
 
 func dataSourceMongoDBAtlasNetworkPeeringRead(d *schema.ResourceData, meta interface{}) error {
 	//Get client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 	projectID := d.Get("project_id").(string)
 	peerID := d.Get("peering_id").(string)
 
+	if d.Get("wait_for_available").(bool) {
+		if err := waitForNetworkPeeringAvailable(conn, projectID, peerID, networkPeeringAvailableTimeout, 30*time.Second); err != nil {
+			return fmt.Errorf("error waiting for Network Peering Connection (%s) to be available: %s", peerID, err)
+		}
+	}
+
 	peer, resp, err := conn.Peers.Get(context.Background(), projectID, peerID)
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
@@ -161,6 +175,10 @@ func dataSourceMongoDBAtlasNetworkPeeringRead(d *schema.ResourceData, meta inter
 		return fmt.Errorf("error setting `status_name` for Network Peering Connection (%s): %s", peerID, err)
 	}
 
+	if err := d.Set("accepter_required", networkPeeringAccepterRequired(peer)); err != nil {
+		return fmt.Errorf("error setting `accepter_required` for Network Peering Connection (%s): %s", peerID, err)
+	}
+
 	if err := d.Set("atlas_cidr_block", peer.AtlasCIDRBlock); err != nil {
 		return fmt.Errorf("error setting `atlas_cidr_block` for Network Peering Connection (%s): %s", peerID, err)
 	}
@@ -0,0 +1,323 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/spf13/cast"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+const (
+	processesPath           = "groups/%s/processes"
+	performanceAdvisorPath  = "groups/%s/processes/%s/performanceAdvisor/%s"
+	errorPerformanceAdvisor = "error getting performance advisor information of cluster (%s): %s"
+)
+
+// process represents a MongoDB process of a project.
+// See more: https://docs.atlas.mongodb.com/reference/api/processes-get-all/
+type process struct {
+	ID             string `json:"id,omitempty"`
+	Hostname       string `json:"hostname,omitempty"`
+	UserAlias      string `json:"userAlias,omitempty"`
+	Port           int    `json:"port,omitempty"`
+	ReplicaSetName string `json:"replicaSetName,omitempty"`
+	TypeName       string `json:"typeName,omitempty"`
+}
+
+// performanceAdvisorNamespace is a namespace with slow queries.
+// See more: https://docs.atlas.mongodb.com/reference/api/pa-namespaces-get-all/
+type performanceAdvisorNamespace struct {
+	Namespace string `json:"namespace,omitempty"`
+	Type      string `json:"type,omitempty"`
+}
+
+// performanceAdvisorSuggestedIndexes holds the suggested indexes and the query shapes they improve.
+// See more: https://docs.atlas.mongodb.com/reference/api/pa-suggested-indexes-get-all/
+type performanceAdvisorSuggestedIndexes struct {
+	Shapes []*struct {
+		ID                string  `json:"id,omitempty"`
+		Namespace         string  `json:"namespace,omitempty"`
+		AvgMs             float64 `json:"avgMs,omitempty"`
+		Count             int64   `json:"count,omitempty"`
+		InefficiencyScore int64   `json:"inefficiencyScore,omitempty"`
+	} `json:"shapes,omitempty"`
+	SuggestedIndexes []*struct {
+		ID        string                   `json:"id,omitempty"`
+		Namespace string                   `json:"namespace,omitempty"`
+		Weight    float64                  `json:"weight,omitempty"`
+		Impact    []string                 `json:"impact,omitempty"`
+		Index     []map[string]interface{} `json:"index,omitempty"`
+	} `json:"suggestedIndexes,omitempty"`
+}
+
+func dataSourceMongoDBAtlasPerformanceAdvisor() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMongoDBAtlasPerformanceAdvisorRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"process_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"namespaces": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"suggested_indexes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"weight": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"impact": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"index": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"field": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"direction": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"shapes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"avg_ms": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"inefficiency_score": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasPerformanceAdvisorRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+	clusterName := d.Get("cluster_name").(string)
+
+	processID := d.Get("process_id").(string)
+	if processID == "" {
+		var err error
+		if processID, err = getClusterPrimaryProcessID(conn, projectID, clusterName); err != nil {
+			return fmt.Errorf(errorPerformanceAdvisor, clusterName, err)
+		}
+	}
+
+	namespaces := new(struct {
+		Namespaces []*performanceAdvisorNamespace `json:"namespaces"`
+	})
+	if err := getPerformanceAdvisor(conn, projectID, processID, "namespaces", namespaces); err != nil {
+		return fmt.Errorf(errorPerformanceAdvisor, clusterName, err)
+	}
+
+	suggestedIndexes := new(performanceAdvisorSuggestedIndexes)
+	if err := getPerformanceAdvisor(conn, projectID, processID, "suggestedIndexes", suggestedIndexes); err != nil {
+		return fmt.Errorf(errorPerformanceAdvisor, clusterName, err)
+	}
+
+	if err := d.Set("process_id", processID); err != nil {
+		return fmt.Errorf(errorPerformanceAdvisor, clusterName, err)
+	}
+	if err := d.Set("namespaces", flattenPerformanceAdvisorNamespaces(namespaces.Namespaces)); err != nil {
+		return fmt.Errorf(errorPerformanceAdvisor, clusterName, err)
+	}
+	if err := d.Set("suggested_indexes", flattenPerformanceAdvisorSuggestedIndexes(suggestedIndexes)); err != nil {
+		return fmt.Errorf(errorPerformanceAdvisor, clusterName, err)
+	}
+	if err := d.Set("shapes", flattenPerformanceAdvisorShapes(suggestedIndexes)); err != nil {
+		return fmt.Errorf(errorPerformanceAdvisor, clusterName, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":   projectID,
+		"cluster_name": clusterName,
+		"process_id":   processID,
+	}))
+
+	return nil
+}
+
+// getClusterPrimaryProcessID returns the `host:port` of the primary of the cluster, or of its
+// first shard, matching the processes of the project against the hosts of the cluster URI.
+func getClusterPrimaryProcessID(conn *matlas.Client, projectID, clusterName string) (string, error) {
+	cluster, _, err := conn.Clusters.Get(context.Background(), projectID, clusterName)
+	if err != nil {
+		return "", err
+	}
+
+	// The ports are ignored since sharded clusters expose the mongos processes in the URI.
+	hosts := make(map[string]bool)
+	for _, host := range strings.Split(strings.TrimPrefix(cluster.MongoURI, "mongodb://"), ",") {
+		hosts[strings.Split(host, ":")[0]] = true
+	}
+
+	processes, _, err := listProjectProcesses(conn, projectID)
+	if err != nil {
+		return "", err
+	}
+
+	var primaries []string
+	for _, p := range processes {
+		if p.TypeName == "REPLICA_PRIMARY" && hosts[p.UserAlias] {
+			primaries = append(primaries, fmt.Sprintf("%s:%d", p.UserAlias, p.Port))
+		}
+	}
+	if len(primaries) == 0 {
+		return "", fmt.Errorf("no primary found for cluster (%s), set `process_id` explicitly", clusterName)
+	}
+
+	sort.Strings(primaries)
+	return primaries[0], nil
+}
+
+func listProjectProcesses(conn *matlas.Client, projectID string) ([]*process, *matlas.Response, error) {
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, fmt.Sprintf(processesPath, projectID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		Results []*process `json:"results"`
+	})
+	resp, err := conn.Do(context.Background(), req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root.Results, resp, nil
+}
+
+func getPerformanceAdvisor(conn *matlas.Client, projectID, processID, endpoint string, v interface{}) error {
+	path := fmt.Sprintf(performanceAdvisorPath, projectID, url.PathEscape(processID), endpoint)
+
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, path, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Do(context.Background(), req, v)
+	return err
+}
+
+func flattenPerformanceAdvisorNamespaces(namespaces []*performanceAdvisorNamespace) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		results = append(results, map[string]interface{}{
+			"namespace": namespace.Namespace,
+			"type":      namespace.Type,
+		})
+	}
+	return results
+}
+
+func flattenPerformanceAdvisorSuggestedIndexes(suggestedIndexes *performanceAdvisorSuggestedIndexes) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0, len(suggestedIndexes.SuggestedIndexes))
+	for _, suggestedIndex := range suggestedIndexes.SuggestedIndexes {
+		// Each key of the index is a single field document, e.g. {"field": 1}.
+		index := make([]map[string]interface{}, 0, len(suggestedIndex.Index))
+		for _, key := range suggestedIndex.Index {
+			for field, direction := range key {
+				index = append(index, map[string]interface{}{
+					"field":     field,
+					"direction": cast.ToString(direction),
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":        suggestedIndex.ID,
+			"namespace": suggestedIndex.Namespace,
+			"weight":    suggestedIndex.Weight,
+			"impact":    suggestedIndex.Impact,
+			"index":     index,
+		})
+	}
+	return results
+}
+
+func flattenPerformanceAdvisorShapes(suggestedIndexes *performanceAdvisorSuggestedIndexes) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0, len(suggestedIndexes.Shapes))
+	for _, shape := range suggestedIndexes.Shapes {
+		results = append(results, map[string]interface{}{
+			"id":                 shape.ID,
+			"namespace":          shape.Namespace,
+			"avg_ms":             shape.AvgMs,
+			"count":              shape.Count,
+			"inefficiency_score": shape.InefficiencyScore,
+		})
+	}
+	return results
+}
@@ -0,0 +1,105 @@
+package mongodbatlas
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+func TestAccDataSourceMongoDBAtlasPerformanceAdvisor_basic(t *testing.T) {
+	resourceName := "data.mongodbatlas_performance_advisor.test"
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+	clusterName := os.Getenv("MONGODB_ATLAS_CLUSTER_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); checkClusterEnv(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasPerformanceAdvisorDataSourceConfig(projectID, clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "process_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "namespaces.#"),
+					resource.TestCheckResourceAttrSet(resourceName, "suggested_indexes.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestGetClusterPrimaryProcessID(t *testing.T) {
+	processes := []*process{
+		{UserAlias: "cluster0-shard-00-01.abcde.mongodb.net", Port: 27017, TypeName: "REPLICA_SECONDARY"},
+		{UserAlias: "cluster0-shard-00-00.abcde.mongodb.net", Port: 27017, TypeName: "REPLICA_PRIMARY"},
+		{UserAlias: "cluster1-shard-00-00.fghij.mongodb.net", Port: 27017, TypeName: "REPLICA_PRIMARY"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/processes") {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"results": processes})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(matlas.Cluster{
+			Name:     "cluster0",
+			MongoURI: "mongodb://cluster0-shard-00-00.abcde.mongodb.net:27017,cluster0-shard-00-01.abcde.mongodb.net:27017",
+		})
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	processID, err := getClusterPrimaryProcessID(client, "5d09d6a59ccf6445652a444a", "cluster0")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if expected := "cluster0-shard-00-00.abcde.mongodb.net:27017"; processID != expected {
+		t.Fatalf("expected process %s, got %s", expected, processID)
+	}
+
+	processes = processes[:1]
+	if _, err := getClusterPrimaryProcessID(client, "5d09d6a59ccf6445652a444a", "cluster0"); err == nil {
+		t.Fatal("expected an error without primary")
+	}
+}
+
+func TestFlattenPerformanceAdvisorSuggestedIndexes(t *testing.T) {
+	suggestedIndexes := new(performanceAdvisorSuggestedIndexes)
+	if err := json.Unmarshal([]byte(`{
+		"shapes": [{"id": "5b74689a80eef53f3388897e", "namespace": "test.users", "avgMs": 42, "count": 2, "inefficiencyScore": 50000}],
+		"suggestedIndexes": [{"id": "5b74689a80eef53f3388897f", "namespace": "test.users", "weight": 37.5, "impact": ["5b74689a80eef53f3388897e"], "index": [{"email": 1}, {"location": "2dsphere"}]}]
+	}`), suggestedIndexes); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	results := flattenPerformanceAdvisorSuggestedIndexes(suggestedIndexes)
+	if len(results) != 1 {
+		t.Fatalf("expected 1 suggested index, got %d", len(results))
+	}
+
+	index := results[0]["index"].([]map[string]interface{})
+	if len(index) != 2 || index[0]["field"] != "email" || index[0]["direction"] != "1" || index[1]["direction"] != "2dsphere" {
+		t.Fatalf("unexpected index keys: %v", index)
+	}
+	if shapes := flattenPerformanceAdvisorShapes(suggestedIndexes); len(shapes) != 1 || shapes[0]["id"] != results[0]["impact"].([]string)[0] {
+		t.Fatalf("expected the impact to reference the shape, got %v", shapes)
+	}
+}
+
+func testAccMongoDBAtlasPerformanceAdvisorDataSourceConfig(projectID, clusterName string) string {
+	return fmt.Sprintf(`
+		data "mongodbatlas_performance_advisor" "test" {
+			project_id   = "%s"
+			cluster_name = "%s"
+		}
+	`, projectID, clusterName)
+}
@@ -0,0 +1,122 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+const privateEndpointServicePath = "groups/%s/privateEndpoint/%s/endpointService/%s"
+
+// privateEndpointService is the private endpoint service that Atlas creates in a cloud provider
+// region, to which the customer-side endpoints connect.
+// See more: https://docs.atlas.mongodb.com/reference/api/private-endpoints-service-get-one/
+type privateEndpointService struct {
+	ID                     string   `json:"id,omitempty"`
+	EndpointServiceName    string   `json:"endpointServiceName,omitempty"`
+	ErrorMessage           string   `json:"errorMessage,omitempty"`
+	InterfaceEndpoints     []string `json:"interfaceEndpoints,omitempty"`
+	PrivateEndpoints       []string `json:"privateEndpoints,omitempty"`
+	PrivateLinkServiceName string   `json:"privateLinkServiceName,omitempty"`
+	Status                 string   `json:"status,omitempty"`
+}
+
+func dataSourceMongoDBAtlasPrivateEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMongoDBAtlasPrivateEndpointRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"private_link_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"provider_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"AWS", "AZURE"}, false),
+			},
+			"endpoint_service_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"interface_endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"private_link_service_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"error_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasPrivateEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+	privateLinkID := d.Get("private_link_id").(string)
+	providerName := d.Get("provider_name").(string)
+
+	service, err := getPrivateEndpointService(conn, projectID, providerName, privateLinkID)
+	if err != nil {
+		return fmt.Errorf("error getting private endpoint service (%s): %s", privateLinkID, err)
+	}
+
+	values := map[string]interface{}{
+		"endpoint_service_name":     service.EndpointServiceName,
+		"interface_endpoints":       service.InterfaceEndpoints,
+		"private_link_service_name": service.PrivateLinkServiceName,
+		"private_endpoints":         service.PrivateEndpoints,
+		"status":                    service.Status,
+		"error_message":             service.ErrorMessage,
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("error setting `%s` for private endpoint service (%s): %s", k, privateLinkID, err)
+		}
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":      projectID,
+		"private_link_id": privateLinkID,
+		"provider_name":   providerName,
+	}))
+
+	return nil
+}
+
+func getPrivateEndpointService(conn *matlas.Client, projectID, providerName, privateLinkID string) (*privateEndpointService, error) {
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, fmt.Sprintf(privateEndpointServicePath, projectID, providerName, privateLinkID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	root := new(privateEndpointService)
+	if _, err := conn.Do(context.Background(), req, root); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
@@ -0,0 +1,82 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+func TestAccDataSourceMongoDBAtlasPrivateEndpoint_basic(t *testing.T) {
+	dataSourceName := "data.mongodbatlas_private_endpoint.test"
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+	privateLinkID := os.Getenv("MONGODB_ATLAS_PRIVATE_LINK_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); checkPrivateEndpointEnv(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasPrivateEndpointDSConfig(projectID, privateLinkID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "project_id", projectID),
+					resource.TestCheckResourceAttr(dataSourceName, "private_link_id", privateLinkID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "endpoint_service_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "status"),
+				),
+			},
+		},
+	})
+}
+
+func TestGetPrivateEndpointService(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/groups/5d09d6a59ccf6445652a444a/privateEndpoint/AWS/endpointService/5df264b8f10fab7d2cad2f0d" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{
+			"id": "5df264b8f10fab7d2cad2f0d",
+			"endpointServiceName": "com.amazonaws.vpce.us-east-1.vpce-svc-0123456789abcdef0",
+			"errorMessage": null,
+			"interfaceEndpoints": ["vpce-0123456789abcdef0"],
+			"regionName": "us-east-1",
+			"status": "AVAILABLE"
+		}`)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	service, err := getPrivateEndpointService(client, "5d09d6a59ccf6445652a444a", "AWS", "5df264b8f10fab7d2cad2f0d")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := &privateEndpointService{
+		ID:                  "5df264b8f10fab7d2cad2f0d",
+		EndpointServiceName: "com.amazonaws.vpce.us-east-1.vpce-svc-0123456789abcdef0",
+		InterfaceEndpoints:  []string{"vpce-0123456789abcdef0"},
+		Status:              "AVAILABLE",
+	}
+	if !reflect.DeepEqual(service, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, service)
+	}
+}
+
+func testAccMongoDBAtlasPrivateEndpointDSConfig(projectID, privateLinkID string) string {
+	return fmt.Sprintf(`
+		data "mongodbatlas_private_endpoint" "test" {
+			project_id      = "%s"
+			private_link_id = "%s"
+			provider_name   = "AWS"
+		}
+	`, projectID, privateLinkID)
+}
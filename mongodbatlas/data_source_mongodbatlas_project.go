@@ -5,8 +5,6 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform/helper/schema"
-
-	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
 )
 
 func dataSourceMongoDBAtlasProject() *schema.Resource {
@@ -29,13 +27,37 @@ func dataSourceMongoDBAtlasProject() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"is_collect_database_specifics_statistics_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_data_explorer_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_performance_advisor_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_realtime_performance_panel_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_schema_advisor_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_slow_operation_thresholding_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 }
 
 func dataSourceMongoDBAtlasProjectRead(d *schema.ResourceData, meta interface{}) error {
 	//Get client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 	projectName := d.Get("name").(string)
 
 	project, _, err := conn.Projects.GetOneProjectByName(context.Background(), projectName)
@@ -55,6 +77,35 @@ func dataSourceMongoDBAtlasProjectRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("error setting `created` for project (%s): %s", d.Id(), err)
 	}
 
+	settings, _, err := getProjectSettings(conn, project.ID)
+	if err != nil {
+		return fmt.Errorf("error getting settings for project (%s): %s", project.ID, err)
+	}
+	if err := d.Set("is_collect_database_specifics_statistics_enabled", settings.IsCollectDatabaseSpecificsStatisticsEnabled); err != nil {
+		return fmt.Errorf("error setting `is_collect_database_specifics_statistics_enabled` for project (%s): %s", project.ID, err)
+	}
+	if err := d.Set("is_data_explorer_enabled", settings.IsDataExplorerEnabled); err != nil {
+		return fmt.Errorf("error setting `is_data_explorer_enabled` for project (%s): %s", project.ID, err)
+	}
+	if err := d.Set("is_performance_advisor_enabled", settings.IsPerformanceAdvisorEnabled); err != nil {
+		return fmt.Errorf("error setting `is_performance_advisor_enabled` for project (%s): %s", project.ID, err)
+	}
+	if err := d.Set("is_realtime_performance_panel_enabled", settings.IsRealtimePerformancePanelEnabled); err != nil {
+		return fmt.Errorf("error setting `is_realtime_performance_panel_enabled` for project (%s): %s", project.ID, err)
+	}
+	if err := d.Set("is_schema_advisor_enabled", settings.IsSchemaAdvisorEnabled); err != nil {
+		return fmt.Errorf("error setting `is_schema_advisor_enabled` for project (%s): %s", project.ID, err)
+	}
+
+	//The slow operation threshold isn't part of the settings, Atlas exposes it with the Performance Advisor.
+	slowOperationThresholding, _, err := getProjectSlowOperationThresholding(conn, project.ID)
+	if err != nil {
+		return fmt.Errorf("error getting slow operation thresholding for project (%s): %s", project.ID, err)
+	}
+	if err := d.Set("is_slow_operation_thresholding_enabled", slowOperationThresholding); err != nil {
+		return fmt.Errorf("error setting `is_slow_operation_thresholding_enabled` for project (%s): %s", project.ID, err)
+	}
+
 	d.SetId(project.ID)
 	return nil
 }
@@ -0,0 +1,128 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+// projectIPAccessListMaxItemsPerPage is the maximum number of entries Atlas returns per page.
+const projectIPAccessListMaxItemsPerPage = 500
+
+func dataSourceMongoDBAtlasProjectIPAccessList() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMongoDBAtlasProjectIPAccessListRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"items_per_page": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      projectIPAccessListMaxItemsPerPage,
+				ValidateFunc: validation.IntBetween(1, projectIPAccessListMaxItemsPerPage),
+			},
+			"total_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"aws_security_group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"comment": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"delete_after_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasProjectIPAccessListRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+
+	// The entries are flattened page by page, only a single page of the API is held at once.
+	results := make([]map[string]interface{}, 0)
+	totalCount, err := listProjectIPAccessList(conn, projectID, d.Get("items_per_page").(int), func(entry *projectIPWhitelist) {
+		results = append(results, map[string]interface{}{
+			"cidr_block":         entry.CIDRBlock,
+			"ip_address":         entry.IPAddress,
+			"aws_security_group": entry.AwsSecurityGroup,
+			"comment":            entry.Comment,
+			"delete_after_date":  entry.DeleteAfterDate,
+		})
+	})
+	if err != nil {
+		return fmt.Errorf(errorProjectIPAccessListRead, err)
+	}
+
+	if err := d.Set("total_count", totalCount); err != nil {
+		return fmt.Errorf("error setting `total_count` for project IP access list (%s): %s", projectID, err)
+	}
+	if err := d.Set("results", results); err != nil {
+		return fmt.Errorf("error setting `results` for project IP access list (%s): %s", projectID, err)
+	}
+
+	d.SetId(projectID)
+
+	return nil
+}
+
+// listProjectIPAccessList requests the pages of the access list of the project, calling f for
+// each entry, until all the entries are read. It returns the total number of entries.
+func listProjectIPAccessList(conn *matlas.Client, projectID string, itemsPerPage int, f func(*projectIPWhitelist)) (int, error) {
+	read := 0
+	for page := 1; ; page++ {
+		path := fmt.Sprintf(projectIPAccessListPath+"?pageNum=%d&itemsPerPage=%d", projectID, page, itemsPerPage)
+
+		req, err := conn.NewRequest(context.Background(), http.MethodGet, path, nil)
+		if err != nil {
+			return 0, err
+		}
+
+		root := new(struct {
+			Results    []*projectIPWhitelist `json:"results"`
+			TotalCount int                   `json:"totalCount"`
+		})
+		if _, err := conn.Do(context.Background(), req, root); err != nil {
+			return 0, err
+		}
+
+		for _, entry := range root.Results {
+			f(entry)
+		}
+		read += len(root.Results)
+
+		if len(root.Results) < itemsPerPage || read >= root.TotalCount {
+			return root.TotalCount, nil
+		}
+	}
+}
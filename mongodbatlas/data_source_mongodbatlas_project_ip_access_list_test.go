@@ -0,0 +1,101 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+func TestAccDataSourceMongoDBAtlasProjectIPAccessList_basic(t *testing.T) {
+	dataSourceName := "data.mongodbatlas_project_ip_access_list.test"
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasProjectIPAccessListDSConfig(projectID, "179.154.224.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "project_id", projectID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "total_count"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "results.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestListProjectIPAccessList(t *testing.T) {
+	var pages []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/groups/5d09d6a59ccf6445652a444a/accessList" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		if itemsPerPage := r.URL.Query().Get("itemsPerPage"); itemsPerPage != "2" {
+			t.Errorf("expected 2 items per page, got %s", itemsPerPage)
+		}
+		pages = append(pages, r.URL.Query().Get("pageNum"))
+
+		switch r.URL.Query().Get("pageNum") {
+		case "1":
+			fmt.Fprint(w, `{"results": [{"ipAddress": "10.0.0.1", "comment": "first"}, {"cidrBlock": "10.1.0.0/16"}], "totalCount": 3}`)
+		case "2":
+			fmt.Fprint(w, `{"results": [{"awsSecurityGroup": "sg-12345678", "deleteAfterDate": "2030-01-01T00:00:00Z"}], "totalCount": 3}`)
+		default:
+			t.Errorf("unexpected page %s", r.URL.RawQuery)
+			fmt.Fprint(w, `{"results": [], "totalCount": 3}`)
+		}
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	var entries []string
+	totalCount, err := listProjectIPAccessList(client, "5d09d6a59ccf6445652a444a", 2, func(entry *projectIPWhitelist) {
+		whiteListMap([]projectIPWhitelist{*entry}, func(e string) {
+			entries = append(entries, e)
+		})
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if totalCount != 3 {
+		t.Fatalf("expected a total count of 3, got %d", totalCount)
+	}
+	if expected := []string{"10.0.0.1", "10.1.0.0/16", "sg-12345678"}; !reflect.DeepEqual(entries, expected) {
+		t.Fatalf("expected entries %v, got %v", expected, entries)
+	}
+	if expected := []string{"1", "2"}; !reflect.DeepEqual(pages, expected) {
+		t.Fatalf("expected pages %v, got %v", expected, pages)
+	}
+}
+
+func testAccMongoDBAtlasProjectIPAccessListDSConfig(projectID, ipAddress string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_project_ip_access_list" "test" {
+			project_id = "%s"
+
+			access_list {
+				ip_address = "%s"
+				comment    = "test data source"
+			}
+		}
+
+		data "mongodbatlas_project_ip_access_list" "test" {
+			project_id     = mongodbatlas_project_ip_access_list.test.project_id
+			items_per_page = 100
+		}
+	`, projectID, ipAddress)
+}
@@ -2,11 +2,14 @@ package mongodbatlas
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
 )
 
@@ -34,12 +37,65 @@ func TestAccDataSourceMongoDBAtlasProject_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckMongoDBAtlasProjectExists(resourceName, &project),
 					resource.TestCheckResourceAttrSet(resourceName, "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "is_performance_advisor_enabled"),
+					resource.TestCheckResourceAttrSet(resourceName, "is_slow_operation_thresholding_enabled"),
 				),
 			},
 		},
 	})
 }
 
+func TestDataSourceMongoDBAtlasProjectRead_performanceSettings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/atlas/v1.0/groups/byName/project0":
+			fmt.Fprint(w, `{"id": "5d09d6a59ccf6445652a444a", "name": "project0", "orgId": "5b71ff2f96e82120d0aaec14", "clusterCount": 1}`)
+		case "/api/atlas/v1.0/groups/5d09d6a59ccf6445652a444a/settings":
+			fmt.Fprint(w, `{
+				"isCollectDatabaseSpecificsStatisticsEnabled": true,
+				"isDataExplorerEnabled": false,
+				"isPerformanceAdvisorEnabled": true,
+				"isRealtimePerformancePanelEnabled": true,
+				"isSchemaAdvisorEnabled": false
+			}`)
+		case "/api/atlas/v2/groups/5d09d6a59ccf6445652a444a/managedSlowMs":
+			fmt.Fprint(w, `true`)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	atlas, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/api/atlas/v1.0/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceMongoDBAtlasProject().Schema, map[string]interface{}{
+		"name": "project0",
+	})
+	if err := dataSourceMongoDBAtlasProjectRead(d, &MongoDBClient{Atlas: atlas}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := map[string]bool{
+		"is_collect_database_specifics_statistics_enabled": true,
+		"is_data_explorer_enabled":                         false,
+		"is_performance_advisor_enabled":                   true,
+		"is_realtime_performance_panel_enabled":            true,
+		"is_schema_advisor_enabled":                        false,
+		"is_slow_operation_thresholding_enabled":           true,
+	}
+	for k, v := range expected {
+		if got := d.Get(k).(bool); got != v {
+			t.Fatalf("expected %s to be %t, got %t", k, v, got)
+		}
+	}
+	if d.Id() != "5d09d6a59ccf6445652a444a" {
+		t.Fatalf("expected the ID of the project, got %s", d.Id())
+	}
+}
+
 func testAccMongoDBAtlasDataSourceProjectConfig(projectName, orgID string) string {
 	return fmt.Sprintf(`
 		resource "mongodbatlas_project" "test" {
@@ -52,7 +52,7 @@ func dataSourceMongoDBAtlasProjects() *schema.Resource {
 
 func dataSourceMongoDBAtlasProjectsRead(d *schema.ResourceData, meta interface{}) error {
 	//Get client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 
 	projects, _, err := conn.Projects.GetAllProjects(context.Background())
 	if err != nil {
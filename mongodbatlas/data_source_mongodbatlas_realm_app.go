@@ -0,0 +1,219 @@
+package mongodbatlas
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	realmBaseURL   = "https://realm.mongodb.com/api/admin/v3.0/"
+	realmLoginPath = "auth/providers/mongodb-cloud/login"
+	realmAppsPath  = "groups/%s/apps"
+)
+
+// realmApp represents a MongoDB Realm application.
+// See more: https://docs.mongodb.com/realm/admin/api/v3/#applications-apis
+type realmApp struct {
+	ID              string `json:"_id,omitempty"`
+	ClientAppID     string `json:"client_app_id,omitempty"`
+	Name            string `json:"name,omitempty"`
+	GroupID         string `json:"group_id,omitempty"`
+	Location        string `json:"location,omitempty"`
+	DeploymentModel string `json:"deployment_model,omitempty"`
+	Product         string `json:"product,omitempty"`
+}
+
+// realmClient is a minimal client of the Realm admin API, which doesn't support the digest
+// authentication of Atlas and requires to exchange the API key for an access token.
+type realmClient struct {
+	lock        sync.Mutex
+	client      *http.Client
+	baseURL     string
+	publicKey   string
+	privateKey  string
+	accessToken string
+}
+
+func newRealmClient(client *http.Client, baseURL, publicKey, privateKey string) *realmClient {
+	return &realmClient{
+		client:     client,
+		baseURL:    baseURL,
+		publicKey:  publicKey,
+		privateKey: privateKey,
+	}
+}
+
+// Get sends a GET request to the path, logging in first if needed. The login is retried once
+// when the access token has expired.
+func (c *realmClient) Get(path string, v interface{}) error {
+	for attempt := 0; ; attempt++ {
+		token, err := c.token(attempt > 0)
+		if err != nil {
+			return err
+		}
+
+		req, err := http.NewRequest(http.MethodGet, c.baseURL+path, nil)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		err = c.do(req, v)
+		if err, ok := err.(*realmErrorResponse); ok && err.StatusCode == http.StatusUnauthorized && attempt == 0 {
+			continue
+		}
+		return err
+	}
+}
+
+func (c *realmClient) token(refresh bool) (string, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.accessToken != "" && !refresh {
+		return c.accessToken, nil
+	}
+
+	body, err := json.Marshal(map[string]string{
+		"username": c.publicKey,
+		"apiKey":   c.privateKey,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.baseURL+realmLoginPath, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+
+	root := new(struct {
+		AccessToken string `json:"access_token"`
+	})
+	if err := c.do(req, root); err != nil {
+		return "", fmt.Errorf("error logging in to the Realm API: %s", err)
+	}
+
+	c.accessToken = root.AccessToken
+	return c.accessToken, nil
+}
+
+func (c *realmClient) do(req *http.Request, v interface{}) error {
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		errorResponse := &realmErrorResponse{StatusCode: resp.StatusCode}
+		_ = json.NewDecoder(resp.Body).Decode(errorResponse)
+		return errorResponse
+	}
+
+	if v == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// realmErrorResponse is the error returned by the Realm admin API.
+type realmErrorResponse struct {
+	StatusCode int    `json:"-"`
+	Message    string `json:"error"`
+	ErrorCode  string `json:"error_code"`
+}
+
+func (e *realmErrorResponse) Error() string {
+	return fmt.Sprintf("%d (%s) %s", e.StatusCode, e.ErrorCode, e.Message)
+}
+
+func dataSourceMongoDBAtlasRealmApp() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMongoDBAtlasRealmAppRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"app_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_app_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_model": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"product": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasRealmAppRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Realm
+	projectID := d.Get("project_id").(string)
+	name := d.Get("name").(string)
+
+	var apps []*realmApp
+	if err := conn.Get(fmt.Sprintf(realmAppsPath, projectID), &apps); err != nil {
+		return fmt.Errorf("error getting realm apps of project (%s): %s", projectID, err)
+	}
+
+	var app *realmApp
+	for _, a := range apps {
+		if a.Name == name {
+			app = a
+			break
+		}
+	}
+	if app == nil {
+		return fmt.Errorf("realm app (%s) not found in project (%s)", name, projectID)
+	}
+
+	if err := d.Set("app_id", app.ID); err != nil {
+		return fmt.Errorf("error setting `app_id` for realm app (%s): %s", name, err)
+	}
+	if err := d.Set("client_app_id", app.ClientAppID); err != nil {
+		return fmt.Errorf("error setting `client_app_id` for realm app (%s): %s", name, err)
+	}
+	if err := d.Set("location", app.Location); err != nil {
+		return fmt.Errorf("error setting `location` for realm app (%s): %s", name, err)
+	}
+	if err := d.Set("deployment_model", app.DeploymentModel); err != nil {
+		return fmt.Errorf("error setting `deployment_model` for realm app (%s): %s", name, err)
+	}
+	if err := d.Set("product", app.Product); err != nil {
+		return fmt.Errorf("error setting `product` for realm app (%s): %s", name, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id": projectID,
+		"app_id":     app.ID,
+	}))
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package mongodbatlas
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceMongoDBAtlasRealmApp_basic(t *testing.T) {
+	resourceName := "data.mongodbatlas_realm_app.test"
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+	appName := os.Getenv("MONGODB_REALM_APP_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); checkRealmEnv(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasRealmAppDataSourceConfig(projectID, appName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", appName),
+					resource.TestCheckResourceAttrSet(resourceName, "app_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "client_app_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestRealmClientGet(t *testing.T) {
+	logins := 0
+	expired := true
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/"+realmLoginPath {
+			var credentials map[string]string
+			_ = json.NewDecoder(r.Body).Decode(&credentials)
+			if credentials["username"] != "public" || credentials["apiKey"] != "private" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			logins++
+			_ = json.NewEncoder(w).Encode(map[string]string{"access_token": fmt.Sprintf("token-%d", logins)})
+			return
+		}
+
+		// The first access token is rejected to simulate its expiration.
+		if r.Header.Get("Authorization") == "Bearer token-1" && expired {
+			w.WriteHeader(http.StatusUnauthorized)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid session", "error_code": "InvalidSession"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode([]*realmApp{{ID: "5f4d", Name: "app", ClientAppID: "app-abcde"}})
+	}))
+	defer server.Close()
+
+	client := newRealmClient(server.Client(), server.URL+"/", "public", "private")
+
+	for i := 0; i < 2; i++ {
+		var apps []*realmApp
+		if err := client.Get(fmt.Sprintf(realmAppsPath, "5d09d6a59ccf6445652a444a"), &apps); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if len(apps) != 1 || apps[0].ClientAppID != "app-abcde" {
+			t.Fatalf("unexpected apps: %v", apps)
+		}
+	}
+	if logins != 2 {
+		t.Fatalf("expected a login and a single re-login after the token expired, got %d logins", logins)
+	}
+
+	unauthorized := newRealmClient(server.Client(), server.URL+"/", "public", "wrong")
+	if err := unauthorized.Get(fmt.Sprintf(realmAppsPath, "5d09d6a59ccf6445652a444a"), nil); err == nil {
+		t.Fatal("expected a login error")
+	}
+}
+
+func testAccMongoDBAtlasRealmAppDataSourceConfig(projectID, appName string) string {
+	return fmt.Sprintf(`
+		data "mongodbatlas_realm_app" "test" {
+			project_id = "%s"
+			name       = "%s"
+		}
+	`, projectID, appName)
+}
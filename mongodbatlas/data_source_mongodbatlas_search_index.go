@@ -0,0 +1,201 @@
+package mongodbatlas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+const searchIndexesPath = "groups/%s/clusters/%s/fts/indexes"
+
+// searchIndex represents an Atlas Search index.
+// See more: https://docs.atlas.mongodb.com/reference/api/fts-indexes-get-one/
+type searchIndex struct {
+	IndexID        string                 `json:"indexID,omitempty"`
+	Name           string                 `json:"name,omitempty"`
+	Database       string                 `json:"database,omitempty"`
+	CollectionName string                 `json:"collectionName,omitempty"`
+	Analyzer       string                 `json:"analyzer,omitempty"`
+	SearchAnalyzer string                 `json:"searchAnalyzer,omitempty"`
+	Mappings       map[string]interface{} `json:"mappings,omitempty"`
+	Status         string                 `json:"status,omitempty"`
+}
+
+func dataSourceMongoDBAtlasSearchIndex() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMongoDBAtlasSearchIndexRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"index_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name"},
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"index_id"},
+			},
+			"database": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"collection_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"analyzer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"search_analyzer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mappings": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasSearchIndexRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+	clusterName := d.Get("cluster_name").(string)
+
+	var index *searchIndex
+
+	if indexID, ok := d.GetOk("index_id"); ok {
+		res, _, err := getSearchIndex(conn, projectID, clusterName, indexID.(string))
+		if err != nil {
+			return fmt.Errorf("error getting search index (%s) information: %s", indexID, err)
+		}
+		index = res
+	} else {
+		name, nameOk := d.GetOk("name")
+		database, databaseOk := d.GetOk("database")
+		collection, collectionOk := d.GetOk("collection_name")
+		if !nameOk || !databaseOk || !collectionOk {
+			return fmt.Errorf("either `index_id` or `name`, `database` and `collection_name` must be set")
+		}
+
+		indexes, _, err := listSearchIndexes(conn, projectID, clusterName, database.(string), collection.(string))
+		if err != nil {
+			return fmt.Errorf("error getting search indexes information: %s", err)
+		}
+		for _, i := range indexes {
+			if i.Name == name.(string) {
+				index = i
+				break
+			}
+		}
+		if index == nil {
+			return fmt.Errorf("search index `%s` not found in collection %s.%s", name, database, collection)
+		}
+	}
+
+	mappings, err := flattenSearchIndexMappings(index.Mappings)
+	if err != nil {
+		return fmt.Errorf("error setting `mappings` for search index (%s): %s", index.IndexID, err)
+	}
+
+	if err := d.Set("index_id", index.IndexID); err != nil {
+		return fmt.Errorf("error setting `index_id` for search index (%s): %s", index.IndexID, err)
+	}
+	if err := d.Set("name", index.Name); err != nil {
+		return fmt.Errorf("error setting `name` for search index (%s): %s", index.IndexID, err)
+	}
+	if err := d.Set("database", index.Database); err != nil {
+		return fmt.Errorf("error setting `database` for search index (%s): %s", index.IndexID, err)
+	}
+	if err := d.Set("collection_name", index.CollectionName); err != nil {
+		return fmt.Errorf("error setting `collection_name` for search index (%s): %s", index.IndexID, err)
+	}
+	if err := d.Set("analyzer", index.Analyzer); err != nil {
+		return fmt.Errorf("error setting `analyzer` for search index (%s): %s", index.IndexID, err)
+	}
+	if err := d.Set("search_analyzer", index.SearchAnalyzer); err != nil {
+		return fmt.Errorf("error setting `search_analyzer` for search index (%s): %s", index.IndexID, err)
+	}
+	if err := d.Set("mappings", mappings); err != nil {
+		return fmt.Errorf("error setting `mappings` for search index (%s): %s", index.IndexID, err)
+	}
+	if err := d.Set("status", index.Status); err != nil {
+		return fmt.Errorf("error setting `status` for search index (%s): %s", index.IndexID, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":   projectID,
+		"cluster_name": clusterName,
+		"index_id":     index.IndexID,
+	}))
+
+	return nil
+}
+
+func flattenSearchIndexMappings(mappings map[string]interface{}) (string, error) {
+	if mappings == nil {
+		return "", nil
+	}
+	b, err := json.Marshal(mappings)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func getSearchIndex(conn *matlas.Client, projectID, clusterName, indexID string) (*searchIndex, *matlas.Response, error) {
+	path := fmt.Sprintf(searchIndexesPath+"/%s", projectID, url.PathEscape(clusterName), url.PathEscape(indexID))
+
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(searchIndex)
+	resp, err := conn.Do(context.Background(), req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
+
+func listSearchIndexes(conn *matlas.Client, projectID, clusterName, database, collection string) ([]*searchIndex, *matlas.Response, error) {
+	path := fmt.Sprintf(searchIndexesPath+"/%s/%s", projectID, url.PathEscape(clusterName), url.PathEscape(database), url.PathEscape(collection))
+
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := make([]*searchIndex, 0)
+	resp, err := conn.Do(context.Background(), req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
@@ -0,0 +1,67 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceMongoDBAtlasSearchIndex_basic(t *testing.T) {
+	resourceName := "data.mongodbatlas_search_index.test"
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+	clusterName := os.Getenv("MONGODB_ATLAS_CLUSTER_NAME")
+	database := os.Getenv("MONGODB_ATLAS_SEARCH_INDEX_DATABASE")
+	collection := os.Getenv("MONGODB_ATLAS_SEARCH_INDEX_COLLECTION")
+	indexName := os.Getenv("MONGODB_ATLAS_SEARCH_INDEX_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); checkSearchIndexEnv(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasSearchIndexDataSourceConfig(projectID, clusterName, database, collection, indexName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "index_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttrSet(resourceName, "mappings"),
+					resource.TestCheckResourceAttr(resourceName, "name", indexName),
+					resource.TestCheckResourceAttr(resourceName, "database", database),
+					resource.TestCheckResourceAttr(resourceName, "collection_name", collection),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasSearchIndexDataSourceConfigByID(projectID, clusterName, database, collection, indexName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.mongodbatlas_search_index.by_id", "name", indexName),
+					resource.TestCheckResourceAttrSet("data.mongodbatlas_search_index.by_id", "status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMongoDBAtlasSearchIndexDataSourceConfig(projectID, clusterName, database, collection, indexName string) string {
+	return fmt.Sprintf(`
+		data "mongodbatlas_search_index" "test" {
+			project_id      = "%s"
+			cluster_name    = "%s"
+			database        = "%s"
+			collection_name = "%s"
+			name            = "%s"
+		}
+	`, projectID, clusterName, database, collection, indexName)
+}
+
+func testAccMongoDBAtlasSearchIndexDataSourceConfigByID(projectID, clusterName, database, collection, indexName string) string {
+	return fmt.Sprintf(`
+		%s
+
+		data "mongodbatlas_search_index" "by_id" {
+			project_id   = data.mongodbatlas_search_index.test.project_id
+			cluster_name = data.mongodbatlas_search_index.test.cluster_name
+			index_id     = data.mongodbatlas_search_index.test.index_id
+		}
+	`, testAccMongoDBAtlasSearchIndexDataSourceConfig(projectID, clusterName, database, collection, indexName))
+}
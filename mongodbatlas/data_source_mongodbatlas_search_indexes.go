@@ -0,0 +1,111 @@
+package mongodbatlas
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceMongoDBAtlasSearchIndexes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMongoDBAtlasSearchIndexesRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"database": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"collection_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"index_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"database": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"collection_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"analyzer": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"search_analyzer": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mappings": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasSearchIndexesRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+	clusterName := d.Get("cluster_name").(string)
+
+	indexes, _, err := listSearchIndexes(conn, projectID, clusterName, d.Get("database").(string), d.Get("collection_name").(string))
+	if err != nil {
+		return fmt.Errorf("error getting search indexes information: %s", err)
+	}
+
+	results := make([]map[string]interface{}, 0, len(indexes))
+	for _, index := range indexes {
+		mappings, err := flattenSearchIndexMappings(index.Mappings)
+		if err != nil {
+			return fmt.Errorf("error setting `mappings` for search index (%s): %s", index.IndexID, err)
+		}
+
+		results = append(results, map[string]interface{}{
+			"index_id":        index.IndexID,
+			"name":            index.Name,
+			"database":        index.Database,
+			"collection_name": index.CollectionName,
+			"analyzer":        index.Analyzer,
+			"search_analyzer": index.SearchAnalyzer,
+			"mappings":        mappings,
+			"status":          index.Status,
+		})
+	}
+
+	if err := d.Set("results", results); err != nil {
+		return fmt.Errorf("error setting `results` for search indexes: %s", err)
+	}
+
+	d.SetId(resource.UniqueId())
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceMongoDBAtlasSearchIndexes_basic(t *testing.T) {
+	resourceName := "data.mongodbatlas_search_indexes.test"
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+	clusterName := os.Getenv("MONGODB_ATLAS_CLUSTER_NAME")
+	database := os.Getenv("MONGODB_ATLAS_SEARCH_INDEX_DATABASE")
+	collection := os.Getenv("MONGODB_ATLAS_SEARCH_INDEX_COLLECTION")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); checkSearchIndexEnv(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasSearchIndexesDataSourceConfig(projectID, clusterName, database, collection),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "results.#"),
+					resource.TestCheckResourceAttrSet(resourceName, "results.0.index_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "results.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMongoDBAtlasSearchIndexesDataSourceConfig(projectID, clusterName, database, collection string) string {
+	return fmt.Sprintf(`
+		data "mongodbatlas_search_indexes" "test" {
+			project_id      = "%s"
+			cluster_name    = "%s"
+			database        = "%s"
+			collection_name = "%s"
+		}
+	`, projectID, clusterName, database, collection)
+}
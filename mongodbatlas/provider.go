@@ -27,35 +27,81 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("MONGODB_ATLAS_PRIVATE_KEY", ""),
 				Description: "MongoDB Atlas Programmatic Private Key",
 			},
+			"retry_on_snapshot_in_progress": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Retry cluster updates rejected while a snapshot is in progress until the update timeout",
+			},
+			"prevent_cluster_deletion": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Fail the deletion of every cluster unless the " + allowClusterDeletionEnv + " environment variable is true",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"mongodbatlas_database_user":                        dataSourceMongoDBAtlasDatabaseUser(),
-			"mongodbatlas_database_users":                       dataSourceMongoDBAtlasDatabaseUsers(),
-			"mongodbatlas_project":                              dataSourceMongoDBAtlasProject(),
-			"mongodbatlas_projects":                             dataSourceMongoDBAtlasProjects(),
-			"mongodbatlas_cluster":                              dataSourceMongoDBAtlasCluster(),
-			"mongodbatlas_clusters":                             dataSourceMongoDBAtlasClusters(),
-			"mongodbatlas_cloud_provider_snapshot":              dataSourceMongoDBAtlasCloudProviderSnapshot(),
-			"mongodbatlas_cloud_provider_snapshots":             dataSourceMongoDBAtlasCloudProviderSnapshots(),
-			"mongodbatlas_network_container":                    dataSourceMongoDBAtlasNetworkContainer(),
-			"mongodbatlas_network_containers":                   dataSourceMongoDBAtlasNetworkContainers(),
-			"mongodbatlas_network_peering":                      dataSourceMongoDBAtlasNetworkPeering(),
-			"mongodbatlas_network_peerings":                     dataSourceMongoDBAtlasNetworkPeerings(),
-			"mongodbatlas_cloud_provider_snapshot_restore_job":  dataSourceMongoDBAtlasCloudProviderSnapshotRestoreJob(),
-			"mongodbatlas_cloud_provider_snapshot_restore_jobs": dataSourceMongoDBAtlasCloudProviderSnapshotRestoreJobs(),
+			"mongodbatlas_database_user":                         dataSourceMongoDBAtlasDatabaseUser(),
+			"mongodbatlas_database_users":                        dataSourceMongoDBAtlasDatabaseUsers(),
+			"mongodbatlas_project":                               dataSourceMongoDBAtlasProject(),
+			"mongodbatlas_projects":                              dataSourceMongoDBAtlasProjects(),
+			"mongodbatlas_cluster":                               dataSourceMongoDBAtlasCluster(),
+			"mongodbatlas_clusters":                              dataSourceMongoDBAtlasClusters(),
+			"mongodbatlas_cloud_provider_snapshot":               dataSourceMongoDBAtlasCloudProviderSnapshot(),
+			"mongodbatlas_cloud_provider_snapshots":              dataSourceMongoDBAtlasCloudProviderSnapshots(),
+			"mongodbatlas_network_container":                     dataSourceMongoDBAtlasNetworkContainer(),
+			"mongodbatlas_network_containers":                    dataSourceMongoDBAtlasNetworkContainers(),
+			"mongodbatlas_network_peering":                       dataSourceMongoDBAtlasNetworkPeering(),
+			"mongodbatlas_network_peerings":                      dataSourceMongoDBAtlasNetworkPeerings(),
+			"mongodbatlas_cloud_provider_snapshot_restore_job":   dataSourceMongoDBAtlasCloudProviderSnapshotRestoreJob(),
+			"mongodbatlas_cloud_provider_snapshot_restore_jobs":  dataSourceMongoDBAtlasCloudProviderSnapshotRestoreJobs(),
+			"mongodbatlas_search_index":                          dataSourceMongoDBAtlasSearchIndex(),
+			"mongodbatlas_search_indexes":                        dataSourceMongoDBAtlasSearchIndexes(),
+			"mongodbatlas_realm_app":                             dataSourceMongoDBAtlasRealmApp(),
+			"mongodbatlas_event_trigger":                         dataSourceMongoDBAtlasEventTrigger(),
+			"mongodbatlas_event_triggers":                        dataSourceMongoDBAtlasEventTriggers(),
+			"mongodbatlas_performance_advisor":                   dataSourceMongoDBAtlasPerformanceAdvisor(),
+			"mongodbatlas_control_plane_ip_addresses":            dataSourceMongoDBAtlasControlPlaneIPAddresses(),
+			"mongodbatlas_api_key":                               dataSourceMongoDBAtlasAPIKey(),
+			"mongodbatlas_api_keys":                              dataSourceMongoDBAtlasAPIKeys(),
+			"mongodbatlas_access_list_api_key":                   dataSourceMongoDBAtlasAccessListAPIKey(),
+			"mongodbatlas_cloud_provider_access":                 dataSourceMongoDBAtlasCloudProviderAccess(),
+			"mongodbatlas_project_ip_access_list":                dataSourceMongoDBAtlasProjectIPAccessList(),
+			"mongodbatlas_private_endpoint":                      dataSourceMongoDBAtlasPrivateEndpoint(),
+			"mongodbatlas_cloud_provider_snapshot_backup_policy": dataSourceMongoDBAtlasCloudProviderSnapshotBackupPolicy(),
+			"mongodbatlas_cluster_cost_estimate":                 dataSourceMongoDBAtlasClusterCostEstimate(),
+			"mongodbatlas_invoice":                               dataSourceMongoDBAtlasInvoice(),
+			"mongodbatlas_invoices":                              dataSourceMongoDBAtlasInvoices(),
+			"mongodbatlas_cluster_logs":                          dataSourceMongoDBAtlasClusterLogs(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"mongodbatlas_database_user":                       resourceMongoDBAtlasDatabaseUser(),
-			"mongodbatlas_project_ip_whitelist":                resourceMongoDBAtlasProjectIPWhitelist(),
-			"mongodbatlas_project":                             resourceMongoDBAtlasProject(),
-			"mongodbatlas_cluster":                             resourceMongoDBAtlasCluster(),
-			"mongodbatlas_cloud_provider_snapshot":             resourceMongoDBAtlasCloudProviderSnapshot(),
-			"mongodbatlas_network_container":                   resourceMongoDBAtlasNetworkContainer(),
-			"mongodbatlas_cloud_provider_snapshot_restore_job": resourceMongoDBAtlasCloudProviderSnapshotRestoreJob(),
-			"mongodbatlas_network_peering":                     resourceMongoDBAtlasNetworkPeering(),
-			"mongodbatlas_encryption_at_rest":                  resourceMongoDBAtlasEncryptionAtRest(),
+			"mongodbatlas_database_user":                         resourceMongoDBAtlasDatabaseUser(),
+			"mongodbatlas_project_ip_whitelist":                  resourceMongoDBAtlasProjectIPWhitelist(),
+			"mongodbatlas_project_ip_access_list":                resourceMongoDBAtlasProjectIPAccessList(),
+			"mongodbatlas_project":                               resourceMongoDBAtlasProject(),
+			"mongodbatlas_project_team":                          resourceMongoDBAtlasProjectTeam(),
+			"mongodbatlas_alert_configuration":                   resourceMongoDBAtlasAlertConfiguration(),
+			"mongodbatlas_cluster":                               resourceMongoDBAtlasCluster(),
+			"mongodbatlas_advanced_cluster":                      resourceMongoDBAtlasAdvancedCluster(),
+			"mongodbatlas_cloud_provider_snapshot":               resourceMongoDBAtlasCloudProviderSnapshot(),
+			"mongodbatlas_network_container":                     resourceMongoDBAtlasNetworkContainer(),
+			"mongodbatlas_cloud_provider_snapshot_restore_job":   resourceMongoDBAtlasCloudProviderSnapshotRestoreJob(),
+			"mongodbatlas_network_peering":                       resourceMongoDBAtlasNetworkPeering(),
+			"mongodbatlas_encryption_at_rest":                    resourceMongoDBAtlasEncryptionAtRest(),
+			"mongodbatlas_cloud_provider_snapshot_backup_policy": resourceMongoDBAtlasCloudProviderSnapshotBackupPolicy(),
+			"mongodbatlas_resource_policy":                       resourceMongoDBAtlasResourcePolicy(),
+			"mongodbatlas_sample_dataset":                        resourceMongoDBAtlasSampleDataset(),
+			"mongodbatlas_mongo_db_employee_access_grant":        resourceMongoDBAtlasMongoDBEmployeeAccessGrant(),
+			"mongodbatlas_custom_db_role":                        resourceMongoDBAtlasCustomDBRole(),
+			"mongodbatlas_federated_query_limit":                 resourceMongoDBAtlasFederatedQueryLimit(),
+			"mongodbatlas_stream_processor":                      resourceMongoDBAtlasStreamProcessor(),
+			"mongodbatlas_ldap_configuration":                    resourceMongoDBAtlasLDAPConfiguration(),
+			"mongodbatlas_teams":                                 resourceMongoDBAtlasTeams(),
+			"mongodbatlas_search_deployment":                     resourceMongoDBAtlasSearchDeployment(),
+			"mongodbatlas_cluster_test_failover":                 resourceMongoDBAtlasClusterTestFailover(),
+			"mongodbatlas_data_lake_pipeline":                    resourceMongoDBAtlasDataLakePipeline(),
 		},
 
 		ConfigureFunc: providerConfigure,
@@ -66,8 +112,11 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	config := Config{
 		PublicKey:  d.Get("public_key").(string),
 		PrivateKey: d.Get("private_key").(string),
+
+		RetryOnSnapshotInProgress: d.Get("retry_on_snapshot_in_progress").(bool),
+		PreventClusterDeletion:    d.Get("prevent_cluster_deletion").(bool),
 	}
-	return config.NewClient(), nil
+	return config.NewClient()
 }
 
 func encodeStateID(values map[string]string) string {
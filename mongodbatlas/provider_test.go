@@ -49,3 +49,57 @@ func checkAwsEnv(t *testing.T) {
 		t.Fatal("`AWS_ACCESS_KEY_ID`, `AWS_VPC_ID`, `AWS_SECRET_ACCESS_KEY` and `AWS_CUSTOMER_MASTER_KEY_ID` must be set for acceptance testing")
 	}
 }
+
+func checkSearchIndexEnv(t *testing.T) {
+	if os.Getenv("MONGODB_ATLAS_CLUSTER_NAME") == "" ||
+		os.Getenv("MONGODB_ATLAS_SEARCH_INDEX_DATABASE") == "" ||
+		os.Getenv("MONGODB_ATLAS_SEARCH_INDEX_COLLECTION") == "" ||
+		os.Getenv("MONGODB_ATLAS_SEARCH_INDEX_NAME") == "" {
+		t.Fatal("`MONGODB_ATLAS_CLUSTER_NAME`, `MONGODB_ATLAS_SEARCH_INDEX_DATABASE`, `MONGODB_ATLAS_SEARCH_INDEX_COLLECTION` and `MONGODB_ATLAS_SEARCH_INDEX_NAME` must be set for search index acceptance testing")
+	}
+}
+
+func checkTeamEnv(t *testing.T) {
+	if os.Getenv("MONGODB_ATLAS_TEAM_ID") == "" {
+		t.Fatal("`MONGODB_ATLAS_TEAM_ID` must be set for project team acceptance testing")
+	}
+}
+
+func checkTeamsEnv(t *testing.T) {
+	if os.Getenv("MONGODB_ATLAS_TEAMS_USERNAME") == "" {
+		t.Fatal("`MONGODB_ATLAS_TEAMS_USERNAME` must be set for teams acceptance testing")
+	}
+}
+
+func checkRealmEnv(t *testing.T) {
+	if os.Getenv("MONGODB_REALM_APP_NAME") == "" ||
+		os.Getenv("MONGODB_REALM_TRIGGER_ID") == "" {
+		t.Fatal("`MONGODB_REALM_APP_NAME` and `MONGODB_REALM_TRIGGER_ID` must be set for realm acceptance testing")
+	}
+}
+
+func checkPrivateEndpointEnv(t *testing.T) {
+	if os.Getenv("MONGODB_ATLAS_PRIVATE_LINK_ID") == "" {
+		t.Fatal("`MONGODB_ATLAS_PRIVATE_LINK_ID` must be set for private endpoint acceptance testing")
+	}
+}
+
+func checkStreamInstanceEnv(t *testing.T) {
+	if os.Getenv("MONGODB_ATLAS_STREAM_INSTANCE_NAME") == "" {
+		t.Fatal("`MONGODB_ATLAS_STREAM_INSTANCE_NAME` must be set for stream processor acceptance testing")
+	}
+}
+
+func checkLDAPEnv(t *testing.T) {
+	if os.Getenv("MONGODB_ATLAS_LDAP_HOSTNAME") == "" ||
+		os.Getenv("MONGODB_ATLAS_LDAP_USERNAME") == "" ||
+		os.Getenv("MONGODB_ATLAS_LDAP_PASSWORD") == "" {
+		t.Fatal("`MONGODB_ATLAS_LDAP_HOSTNAME`, `MONGODB_ATLAS_LDAP_USERNAME` and `MONGODB_ATLAS_LDAP_PASSWORD` must be set for LDAP configuration acceptance testing")
+	}
+}
+
+func checkClusterEnv(t *testing.T) {
+	if os.Getenv("MONGODB_ATLAS_CLUSTER_NAME") == "" {
+		t.Fatal("`MONGODB_ATLAS_CLUSTER_NAME` must be set for acceptance testing against an existing cluster")
+	}
+}
@@ -0,0 +1,725 @@
+package mongodbatlas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/mwielbut/pointy"
+	"github.com/spf13/cast"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+const (
+	errorAdvancedClusterCreate = "error creating MongoDB Advanced Cluster: %s"
+	errorAdvancedClusterRead   = "error reading MongoDB Advanced Cluster (%s): %s"
+	errorAdvancedClusterUpdate = "error updating MongoDB Advanced Cluster (%s): %s"
+	errorAdvancedClusterDelete = "error deleting MongoDB Advanced Cluster (%s): %s"
+)
+
+func resourceMongoDBAtlasAdvancedCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMongoDBAtlasAdvancedClusterCreate,
+		Read:   resourceMongoDBAtlasAdvancedClusterRead,
+		Update: resourceMongoDBAtlasAdvancedClusterUpdate,
+		Delete: resourceMongoDBAtlasAdvancedClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMongoDBAtlasAdvancedClusterImportState,
+		},
+		CustomizeDiff: resourceMongoDBAtlasAdvancedClusterCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Hour),
+			Update: schema.DefaultTimeout(3 * time.Hour),
+			Delete: schema.DefaultTimeout(3 * time.Hour),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"REPLICASET", "SHARDED", "GEOSHARDED"}, false),
+			},
+			"backup_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"pit_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"disk_size_gb": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
+			},
+			"encryption_at_rest_provider": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"mongo_db_major_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"bi_connector": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"read_preference": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(biConnectorReadPreferences, false),
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return !d.Get("bi_connector.0.enabled").(bool)
+							},
+						},
+					},
+				},
+			},
+			"replication_specs": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"num_shards": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  1,
+						},
+						"zone_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"region_configs": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"provider_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{"AWS", "GCP", "AZURE", "TENANT"}, false),
+									},
+									"backing_provider_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"region_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"priority": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(0, 7),
+									},
+									"electable_specs":        advancedClusterNodeSpecsSchema("auto_scaling"),
+									"read_only_specs":        advancedClusterNodeSpecsSchema("auto_scaling"),
+									"analytics_specs":        advancedClusterNodeSpecsSchema("analytics_auto_scaling"),
+									"auto_scaling":           advancedClusterAutoScalingSchema(),
+									"analytics_auto_scaling": advancedClusterAutoScalingSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"mongo_db_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_strings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"standard": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"standard_srv": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_srv": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"paused": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"state_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": resourceTagsSchema(),
+		},
+	}
+}
+
+// advancedClusterNodeSpecsSchema returns the schema of the specs of a node type, scaled by the
+// compute auto-scaling of the given block of the region config.
+func advancedClusterNodeSpecsSchema(autoScalingKey string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"instance_size": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: advancedClusterInstanceSizeDiffSuppressFunc(autoScalingKey),
+				},
+				"node_count": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Default:  0,
+				},
+				"disk_iops": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"ebs_volume_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice([]string{"STANDARD", "PROVISIONED"}, false),
+				},
+			},
+		},
+	}
+}
+
+func advancedClusterAutoScalingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"disk_gb_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Computed: true,
+				},
+				"compute_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Computed: true,
+				},
+				"compute_scale_down_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Computed: true,
+				},
+				"compute_min_instance_size": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"compute_max_instance_size": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// advancedClusterInstanceSizeDiffSuppressFunc ignores the instance size of a node type once the
+// cluster exists if compute auto-scaling is enabled for it, e.g. the analytics nodes may have
+// scaled to another tier than the electable nodes.
+func advancedClusterInstanceSizeDiffSuppressFunc(autoScalingKey string) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if old == "" || d.Id() == "" {
+			return false
+		}
+		// k is replication_specs.N.region_configs.N.<node type>_specs.0.instance_size.
+		parts := strings.Split(k, ".")
+		if len(parts) < 3 {
+			return false
+		}
+		regionConfig := strings.Join(parts[:len(parts)-3], ".")
+		return d.Get(regionConfig + "." + autoScalingKey + ".0.compute_enabled").(bool)
+	}
+}
+
+func resourceMongoDBAtlasAdvancedClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+
+	request, err := expandAdvancedCluster(d)
+	if err != nil {
+		return fmt.Errorf(errorAdvancedClusterCreate, err)
+	}
+	request.Name = d.Get("name").(string)
+
+	cluster, _, err := createAdvancedCluster(conn, projectID, request)
+	if err != nil {
+		return fmt.Errorf(errorAdvancedClusterCreate, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"CREATING", "UPDATING", "REPAIRING", "REPEATING", "PENDING"},
+		Target:     []string{"IDLE"},
+		Refresh:    advancedClusterRefreshFunc(request.Name, projectID, conn),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 30 * time.Second,
+		Delay:      1 * time.Minute,
+	}
+
+	// Wait, catching any errors
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(errorAdvancedClusterCreate, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"cluster_id":   cluster.ID,
+		"project_id":   projectID,
+		"cluster_name": request.Name,
+	}))
+
+	return resourceMongoDBAtlasAdvancedClusterRead(d, meta)
+}
+
+func resourceMongoDBAtlasAdvancedClusterRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	projectID := ids["project_id"]
+	clusterName := ids["cluster_name"]
+
+	cluster, resp, err := getAdvancedCluster(conn, projectID, clusterName)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(errorAdvancedClusterRead, clusterName, err)
+	}
+
+	if err := d.Set("cluster_id", cluster.ID); err != nil {
+		return fmt.Errorf(errorAdvancedClusterRead, clusterName, err)
+	}
+	if err := d.Set("cluster_type", cluster.ClusterType); err != nil {
+		return fmt.Errorf(errorAdvancedClusterRead, clusterName, err)
+	}
+	if err := d.Set("backup_enabled", cluster.BackupEnabled); err != nil {
+		return fmt.Errorf(errorAdvancedClusterRead, clusterName, err)
+	}
+	if err := d.Set("pit_enabled", cluster.PitEnabled); err != nil {
+		return fmt.Errorf(errorAdvancedClusterRead, clusterName, err)
+	}
+	if err := d.Set("disk_size_gb", cluster.DiskSizeGB); err != nil {
+		return fmt.Errorf(errorAdvancedClusterRead, clusterName, err)
+	}
+	if err := d.Set("encryption_at_rest_provider", cluster.EncryptionAtRestProvider); err != nil {
+		return fmt.Errorf(errorAdvancedClusterRead, clusterName, err)
+	}
+	if err := d.Set("mongo_db_major_version", cluster.MongoDBMajorVersion); err != nil {
+		return fmt.Errorf(errorAdvancedClusterRead, clusterName, err)
+	}
+	if err := d.Set("mongo_db_version", cluster.MongoDBVersion); err != nil {
+		return fmt.Errorf(errorAdvancedClusterRead, clusterName, err)
+	}
+	if err := d.Set("paused", cluster.Paused); err != nil {
+		return fmt.Errorf(errorAdvancedClusterRead, clusterName, err)
+	}
+	if err := d.Set("state_name", cluster.StateName); err != nil {
+		return fmt.Errorf(errorAdvancedClusterRead, clusterName, err)
+	}
+	if err := d.Set("bi_connector", flattenAdvancedClusterBiConnector(cluster.BiConnector)); err != nil {
+		return fmt.Errorf(errorAdvancedClusterRead, clusterName, err)
+	}
+	if err := d.Set("connection_strings", flattenAdvancedClusterConnectionStrings(cluster.ConnectionStrings)); err != nil {
+		return fmt.Errorf(errorAdvancedClusterRead, clusterName, err)
+	}
+	if err := d.Set("replication_specs", flattenAdvancedClusterReplicationSpecs(cluster.ReplicationSpecs)); err != nil {
+		return fmt.Errorf(errorAdvancedClusterRead, clusterName, err)
+	}
+	if err := d.Set("tags", flattenResourceTags(cluster.Tags)); err != nil {
+		return fmt.Errorf(errorAdvancedClusterRead, clusterName, err)
+	}
+
+	return nil
+}
+
+func resourceMongoDBAtlasAdvancedClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	projectID := ids["project_id"]
+	clusterName := ids["cluster_name"]
+
+	request, err := expandAdvancedCluster(d)
+	if err != nil {
+		return fmt.Errorf(errorAdvancedClusterUpdate, clusterName, err)
+	}
+
+	if _, _, err := updateAdvancedCluster(conn, projectID, clusterName, request); err != nil {
+		return fmt.Errorf(errorAdvancedClusterUpdate, clusterName, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"CREATING", "UPDATING", "REPAIRING", "REPEATING", "PENDING"},
+		Target:     []string{"IDLE"},
+		Refresh:    advancedClusterRefreshFunc(clusterName, projectID, conn),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		MinTimeout: 30 * time.Second,
+		Delay:      1 * time.Minute,
+	}
+
+	// Wait, catching any errors
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(errorAdvancedClusterUpdate, clusterName, err)
+	}
+
+	return resourceMongoDBAtlasAdvancedClusterRead(d, meta)
+}
+
+func resourceMongoDBAtlasAdvancedClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	projectID := ids["project_id"]
+	clusterName := ids["cluster_name"]
+
+	if err := validateClusterDeletion(meta.(*MongoDBClient).preventClusterDeletion, clusterName); err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf(advancedClustersPath+"/%s", projectID, url.PathEscape(clusterName))
+
+	req, err := conn.NewRequest(context.Background(), http.MethodDelete, path, nil)
+	if err != nil {
+		return fmt.Errorf(errorAdvancedClusterDelete, clusterName, err)
+	}
+	if _, err := conn.Do(context.Background(), req, nil); err != nil {
+		return fmt.Errorf(errorAdvancedClusterDelete, clusterName, err)
+	}
+
+	log.Println("[INFO] Waiting for MongoDB Advanced Cluster to be destroyed")
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"IDLE", "CREATING", "UPDATING", "REPAIRING", "REPEATING", "DELETING", "PENDING"},
+		Target:     []string{"DELETED"},
+		Refresh:    advancedClusterRefreshFunc(clusterName, projectID, conn),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 30 * time.Second,
+		Delay:      1 * time.Minute,
+	}
+
+	// Wait, catching any errors
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(errorAdvancedClusterDelete, clusterName, err)
+	}
+	return nil
+}
+
+func resourceMongoDBAtlasAdvancedClusterCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	// Disk auto-scaling may have grown the disk beyond the configured size.
+	for _, s := range d.Get("replication_specs").([]interface{}) {
+		spec, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, r := range spec["region_configs"].([]interface{}) {
+			region, ok := r.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if l, ok := region["auto_scaling"].([]interface{}); ok && len(l) > 0 && l[0] != nil {
+				if cast.ToBool(l[0].(map[string]interface{})["disk_gb_enabled"]) {
+					return nil
+				}
+			}
+		}
+	}
+
+	return validateDiskSizeGBChange(d.GetChange("disk_size_gb"))
+}
+
+func resourceMongoDBAtlasAdvancedClusterImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*MongoDBClient).Atlas
+
+	parts := strings.SplitN(d.Id(), "-", 2)
+	if len(parts) != 2 {
+		return nil, errors.New("import format error: to import an advanced cluster, use the format {project_id}-{name}")
+	}
+
+	projectID := parts[0]
+	name := parts[1]
+
+	u, _, err := getAdvancedCluster(conn, projectID, name)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't import advanced cluster %s in project %s, error: %s", name, projectID, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"cluster_id":   u.ID,
+		"project_id":   projectID,
+		"cluster_name": u.Name,
+	}))
+
+	if err := d.Set("project_id", projectID); err != nil {
+		log.Printf("[WARN] Error setting project_id for (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("name", u.Name); err != nil {
+		log.Printf("[WARN] Error setting name for (%s): %s", d.Id(), err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandAdvancedCluster(d *schema.ResourceData) (*advancedCluster, error) {
+	request := &advancedCluster{
+		ClusterType:              d.Get("cluster_type").(string),
+		EncryptionAtRestProvider: d.Get("encryption_at_rest_provider").(string),
+		MongoDBMajorVersion:      d.Get("mongo_db_major_version").(string),
+		ReplicationSpecs:         make([]*advancedReplicationSpec, 0),
+	}
+
+	if v, ok := d.GetOkExists("backup_enabled"); ok {
+		request.BackupEnabled = pointy.Bool(v.(bool))
+	}
+	if v, ok := d.GetOkExists("pit_enabled"); ok {
+		request.PitEnabled = pointy.Bool(v.(bool))
+	}
+	if v, ok := d.GetOk("disk_size_gb"); ok {
+		request.DiskSizeGB = pointy.Float64(v.(float64))
+	}
+	if v, ok := d.GetOk("tags"); ok || d.HasChange("tags") {
+		request.Tags = expandResourceTags(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("bi_connector"); ok {
+		if l := v.([]interface{}); len(l) > 0 && l[0] != nil {
+			biConnector := l[0].(map[string]interface{})
+			request.BiConnector = &matlas.BiConnector{
+				Enabled:        pointy.Bool(cast.ToBool(biConnector["enabled"])),
+				ReadPreference: cast.ToString(biConnector["read_preference"]),
+			}
+		}
+	}
+
+	for _, s := range d.Get("replication_specs").([]interface{}) {
+		spec := s.(map[string]interface{})
+
+		rSpec := &advancedReplicationSpec{
+			ID:            cast.ToString(spec["id"]),
+			NumShards:     cast.ToInt(spec["num_shards"]),
+			ZoneName:      cast.ToString(spec["zone_name"]),
+			RegionConfigs: make([]*advancedRegionConfig, 0),
+		}
+
+		for _, r := range spec["region_configs"].([]interface{}) {
+			region := r.(map[string]interface{})
+
+			regionName, err := valRegion(region["region_name"])
+			if err != nil {
+				return nil, err
+			}
+
+			rSpec.RegionConfigs = append(rSpec.RegionConfigs, &advancedRegionConfig{
+				ProviderName:         cast.ToString(region["provider_name"]),
+				BackingProviderName:  cast.ToString(region["backing_provider_name"]),
+				RegionName:           regionName,
+				Priority:             pointy.Int(cast.ToInt(region["priority"])),
+				ElectableSpecs:       expandAdvancedClusterNodeSpecs(region["electable_specs"]),
+				ReadOnlySpecs:        expandAdvancedClusterNodeSpecs(region["read_only_specs"]),
+				AnalyticsSpecs:       expandAdvancedClusterNodeSpecs(region["analytics_specs"]),
+				AutoScaling:          expandAdvancedClusterAutoScaling(region["auto_scaling"]),
+				AnalyticsAutoScaling: expandAdvancedClusterAutoScaling(region["analytics_auto_scaling"]),
+			})
+		}
+
+		request.ReplicationSpecs = append(request.ReplicationSpecs, rSpec)
+	}
+
+	return request, nil
+}
+
+func expandAdvancedClusterNodeSpecs(v interface{}) *regionNodeSpecs {
+	l, ok := v.([]interface{})
+	if !ok || len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	specs := l[0].(map[string]interface{})
+
+	nodeSpecs := &regionNodeSpecs{
+		InstanceSize:  cast.ToString(specs["instance_size"]),
+		NodeCount:     pointy.Int(cast.ToInt(specs["node_count"])),
+		EbsVolumeType: cast.ToString(specs["ebs_volume_type"]),
+	}
+	if diskIOPS := cast.ToInt64(specs["disk_iops"]); diskIOPS > 0 {
+		nodeSpecs.DiskIOPS = pointy.Int64(diskIOPS)
+	}
+	return nodeSpecs
+}
+
+func expandAdvancedClusterAutoScaling(v interface{}) *advancedAutoScaling {
+	l, ok := v.([]interface{})
+	if !ok || len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	autoScaling := l[0].(map[string]interface{})
+
+	return &advancedAutoScaling{
+		DiskGB: &advancedDiskGBAutoScaling{
+			Enabled: pointy.Bool(cast.ToBool(autoScaling["disk_gb_enabled"])),
+		},
+		Compute: &advancedComputeAutoScaling{
+			Enabled:          pointy.Bool(cast.ToBool(autoScaling["compute_enabled"])),
+			ScaleDownEnabled: pointy.Bool(cast.ToBool(autoScaling["compute_scale_down_enabled"])),
+			MinInstanceSize:  cast.ToString(autoScaling["compute_min_instance_size"]),
+			MaxInstanceSize:  cast.ToString(autoScaling["compute_max_instance_size"]),
+		},
+	}
+}
+
+func flattenAdvancedClusterBiConnector(biConnector *matlas.BiConnector) []map[string]interface{} {
+	if biConnector == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"enabled":         biConnector.Enabled,
+			"read_preference": biConnector.ReadPreference,
+		},
+	}
+}
+
+func flattenAdvancedClusterConnectionStrings(connectionStrings *advancedConnectionStrings) []map[string]interface{} {
+	if connectionStrings == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"standard":     connectionStrings.Standard,
+			"standard_srv": connectionStrings.StandardSrv,
+			"private":      connectionStrings.Private,
+			"private_srv":  connectionStrings.PrivateSrv,
+		},
+	}
+}
+
+func flattenAdvancedClusterReplicationSpecs(rSpecs []*advancedReplicationSpec) []map[string]interface{} {
+	specs := make([]map[string]interface{}, 0, len(rSpecs))
+	for _, rSpec := range rSpecs {
+		regions := make([]map[string]interface{}, 0, len(rSpec.RegionConfigs))
+		for _, regionConfig := range rSpec.RegionConfigs {
+			regions = append(regions, map[string]interface{}{
+				"provider_name":          regionConfig.ProviderName,
+				"backing_provider_name":  regionConfig.BackingProviderName,
+				"region_name":            regionConfig.RegionName,
+				"priority":               regionConfig.Priority,
+				"electable_specs":        flattenAdvancedClusterNodeSpecs(regionConfig.ElectableSpecs),
+				"read_only_specs":        flattenAdvancedClusterNodeSpecs(regionConfig.ReadOnlySpecs),
+				"analytics_specs":        flattenAdvancedClusterNodeSpecs(regionConfig.AnalyticsSpecs),
+				"auto_scaling":           flattenAdvancedClusterAutoScaling(regionConfig.AutoScaling),
+				"analytics_auto_scaling": flattenAdvancedClusterAutoScaling(regionConfig.AnalyticsAutoScaling),
+			})
+		}
+
+		specs = append(specs, map[string]interface{}{
+			"id":             rSpec.ID,
+			"num_shards":     rSpec.NumShards,
+			"zone_name":      rSpec.ZoneName,
+			"region_configs": regions,
+		})
+	}
+	return specs
+}
+
+func flattenAdvancedClusterNodeSpecs(specs *regionNodeSpecs) []map[string]interface{} {
+	// Atlas returns empty specs for the node types that are not deployed in the region.
+	if specs == nil || specs.NodeCount == nil || *specs.NodeCount == 0 {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"instance_size":   specs.InstanceSize,
+			"node_count":      specs.NodeCount,
+			"disk_iops":       specs.DiskIOPS,
+			"ebs_volume_type": specs.EbsVolumeType,
+		},
+	}
+}
+
+func flattenAdvancedClusterAutoScaling(autoScaling *advancedAutoScaling) []map[string]interface{} {
+	if autoScaling == nil {
+		return nil
+	}
+
+	result := map[string]interface{}{}
+	if autoScaling.DiskGB != nil {
+		result["disk_gb_enabled"] = autoScaling.DiskGB.Enabled
+	}
+	if autoScaling.Compute != nil {
+		result["compute_enabled"] = autoScaling.Compute.Enabled
+		result["compute_scale_down_enabled"] = autoScaling.Compute.ScaleDownEnabled
+		result["compute_min_instance_size"] = autoScaling.Compute.MinInstanceSize
+		result["compute_max_instance_size"] = autoScaling.Compute.MaxInstanceSize
+	}
+	return []map[string]interface{}{result}
+}
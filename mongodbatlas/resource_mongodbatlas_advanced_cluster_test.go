@@ -0,0 +1,342 @@
+package mongodbatlas
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccResourceMongoDBAtlasAdvancedCluster_basic(t *testing.T) {
+	var cluster advancedCluster
+
+	resourceName := "mongodbatlas_advanced_cluster.test"
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+	name := fmt.Sprintf("test-acc-%s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBAtlasAdvancedClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasAdvancedClusterConfig(projectID, name, "M10", 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasAdvancedClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttrSet(resourceName, "cluster_id"),
+					resource.TestCheckResourceAttr(resourceName, "project_id", projectID),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "replication_specs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_specs.0.region_configs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_specs.0.region_configs.0.electable_specs.0.instance_size", "M10"),
+					resource.TestCheckResourceAttr(resourceName, "replication_specs.0.region_configs.0.electable_specs.0.node_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "replication_specs.0.region_configs.0.analytics_specs.0.node_count", "1"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasAdvancedClusterConfig(projectID, name, "M20", 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasAdvancedClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "replication_specs.0.region_configs.0.electable_specs.0.instance_size", "M20"),
+					resource.TestCheckResourceAttr(resourceName, "replication_specs.0.region_configs.0.electable_specs.0.node_count", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceMongoDBAtlasAdvancedCluster_multiCloud(t *testing.T) {
+	var cluster advancedCluster
+
+	resourceName := "mongodbatlas_advanced_cluster.test"
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+	name := fmt.Sprintf("test-acc-multi-cloud-%s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBAtlasAdvancedClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasAdvancedClusterConfigMultiCloud(projectID, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasAdvancedClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "replication_specs.0.region_configs.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "replication_specs.0.region_configs.0.provider_name", "AWS"),
+					resource.TestCheckResourceAttr(resourceName, "replication_specs.0.region_configs.1.provider_name", "GCP"),
+					resource.TestCheckResourceAttr(resourceName, "replication_specs.0.region_configs.1.read_only_specs.0.node_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceMongoDBAtlasAdvancedCluster_importBasic(t *testing.T) {
+	resourceName := "mongodbatlas_advanced_cluster.test"
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+	name := fmt.Sprintf("test-acc-%s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBAtlasAdvancedClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasAdvancedClusterConfig(projectID, name, "M10", 3),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     fmt.Sprintf("%s-%s", projectID, name),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAdvancedClusterAnalyticsAutoScaling(t *testing.T) {
+	// The electable nodes scaled from M10 to M30 and the analytics nodes, independently, to M20.
+	cluster := new(advancedCluster)
+	err := json.Unmarshal([]byte(`{
+		"name": "cluster0",
+		"clusterType": "REPLICASET",
+		"replicationSpecs": [{
+			"id": "5e2211c17a3e5a48f5497de3",
+			"numShards": 1,
+			"zoneName": "Zone 1",
+			"regionConfigs": [{
+				"providerName": "AWS",
+				"regionName": "US_EAST_1",
+				"priority": 7,
+				"electableSpecs": {"instanceSize": "M30", "nodeCount": 3},
+				"analyticsSpecs": {"instanceSize": "M20", "nodeCount": 1},
+				"autoScaling": {
+					"diskGB": {"enabled": true},
+					"compute": {"enabled": true, "scaleDownEnabled": true, "minInstanceSize": "M10", "maxInstanceSize": "M40"}
+				},
+				"analyticsAutoScaling": {
+					"diskGB": {"enabled": true},
+					"compute": {"enabled": true, "scaleDownEnabled": true, "minInstanceSize": "M10", "maxInstanceSize": "M30"}
+				}
+			}]
+		}]
+	}`), cluster)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	r := resourceMongoDBAtlasAdvancedCluster()
+	d := r.TestResourceData()
+	d.SetId("cluster0")
+	_ = d.Set("project_id", "5d09d6a59ccf6445652a444a")
+	_ = d.Set("name", cluster.Name)
+	_ = d.Set("cluster_type", cluster.ClusterType)
+	if err := d.Set("replication_specs", flattenAdvancedClusterReplicationSpecs(cluster.ReplicationSpecs)); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	state := d.State()
+
+	autoScaling := func(enabled bool, maxInstanceSize string) []interface{} {
+		return []interface{}{map[string]interface{}{
+			"disk_gb_enabled":            true,
+			"compute_enabled":            enabled,
+			"compute_scale_down_enabled": enabled,
+			"compute_min_instance_size":  "M10",
+			"compute_max_instance_size":  maxInstanceSize,
+		}}
+	}
+	clusterConfig := func(analyticsAutoScaling []interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"project_id":   "5d09d6a59ccf6445652a444a",
+			"name":         "cluster0",
+			"cluster_type": "REPLICASET",
+			"replication_specs": []interface{}{map[string]interface{}{
+				"region_configs": []interface{}{map[string]interface{}{
+					"provider_name":          "AWS",
+					"region_name":            "US_EAST_1",
+					"priority":               7,
+					"electable_specs":        []interface{}{map[string]interface{}{"instance_size": "M10", "node_count": 3}},
+					"analytics_specs":        []interface{}{map[string]interface{}{"instance_size": "M10", "node_count": 1}},
+					"auto_scaling":           autoScaling(true, "M40"),
+					"analytics_auto_scaling": analyticsAutoScaling,
+				}},
+			}},
+		}
+	}
+
+	cases := []struct {
+		name         string
+		config       map[string]interface{}
+		expectedDiff bool
+	}{
+		{
+			name:         "both node types auto-scaled",
+			config:       clusterConfig(autoScaling(true, "M30")),
+			expectedDiff: false,
+		},
+		{
+			name:         "analytics nodes not auto-scaled",
+			config:       clusterConfig(autoScaling(false, "M30")),
+			expectedDiff: true,
+		},
+	}
+
+	for _, c := range cases {
+		raw, err := config.NewRawConfig(c.config)
+		if err != nil {
+			t.Fatalf("%s: err: %s", c.name, err)
+		}
+
+		diff, err := r.Diff(state, terraform.NewResourceConfig(raw), nil)
+		if err != nil {
+			t.Fatalf("%s: err: %s", c.name, err)
+		}
+		// Only the replication specs are set in the state, the other computed attributes differ.
+		hasDiff := false
+		for k := range diff.Attributes {
+			hasDiff = hasDiff || strings.HasPrefix(k, "replication_specs.")
+		}
+		if hasDiff != c.expectedDiff {
+			t.Fatalf("%s: expected diff %t, got %v", c.name, c.expectedDiff, diff)
+		}
+		if !c.expectedDiff {
+			continue
+		}
+		if attr, ok := diff.Attributes["replication_specs.0.region_configs.0.analytics_specs.0.instance_size"]; !ok || attr.New != "M10" {
+			t.Fatalf("%s: expected the analytics instance size to change to M10, got %v", c.name, diff)
+		}
+		if _, ok := diff.Attributes["replication_specs.0.region_configs.0.electable_specs.0.instance_size"]; ok {
+			t.Fatalf("%s: expected no change of the auto-scaled electable instance size, got %v", c.name, diff)
+		}
+	}
+
+	// The analytics auto-scaling is sent on its own, next to the one of the other node types.
+	request, err := expandAdvancedCluster(d)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	region := request.ReplicationSpecs[0].RegionConfigs[0]
+	if region.AnalyticsAutoScaling == nil || region.AnalyticsAutoScaling.Compute.MaxInstanceSize != "M30" {
+		t.Fatalf("expected the analytics auto-scaling up to M30, got %+v", region.AnalyticsAutoScaling)
+	}
+	if region.AutoScaling == nil || region.AutoScaling.Compute.MaxInstanceSize != "M40" {
+		t.Fatalf("expected the auto-scaling up to M40, got %+v", region.AutoScaling)
+	}
+}
+
+func testAccCheckMongoDBAtlasAdvancedClusterExists(resourceName string, cluster *advancedCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*MongoDBClient).Atlas
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		log.Printf("[DEBUG] projectID: %s", rs.Primary.Attributes["project_id"])
+
+		if clusterResp, _, err := getAdvancedCluster(conn, rs.Primary.Attributes["project_id"], rs.Primary.Attributes["name"]); err == nil {
+			*cluster = *clusterResp
+			return nil
+		}
+
+		return fmt.Errorf("advanced cluster(%s:%s) does not exist", rs.Primary.Attributes["project_id"], rs.Primary.ID)
+	}
+}
+
+func testAccCheckMongoDBAtlasAdvancedClusterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*MongoDBClient).Atlas
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongodbatlas_advanced_cluster" {
+			continue
+		}
+
+		// Try to find the cluster
+		_, _, err := getAdvancedCluster(conn, rs.Primary.Attributes["project_id"], rs.Primary.Attributes["name"])
+
+		if err == nil {
+			return fmt.Errorf("advanced cluster (%s:%s) still exists", rs.Primary.Attributes["name"], rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccMongoDBAtlasAdvancedClusterConfig(projectID, name, instanceSize string, nodeCount int) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_advanced_cluster" "test" {
+			project_id   = "%[1]s"
+			name         = "%[2]s"
+			cluster_type = "REPLICASET"
+
+			replication_specs {
+				region_configs {
+					provider_name = "AWS"
+					region_name   = "US_EAST_1"
+					priority      = 7
+
+					electable_specs {
+						instance_size = "%[3]s"
+						node_count    = %[4]d
+					}
+					analytics_specs {
+						instance_size = "%[3]s"
+						node_count    = 1
+					}
+				}
+			}
+		}
+	`, projectID, name, instanceSize, nodeCount)
+}
+
+func testAccMongoDBAtlasAdvancedClusterConfigMultiCloud(projectID, name string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_advanced_cluster" "test" {
+			project_id   = "%s"
+			name         = "%s"
+			cluster_type = "REPLICASET"
+
+			replication_specs {
+				region_configs {
+					provider_name = "AWS"
+					region_name   = "US_EAST_1"
+					priority      = 7
+
+					electable_specs {
+						instance_size = "M10"
+						node_count    = 3
+					}
+					auto_scaling {
+						disk_gb_enabled = true
+					}
+				}
+				region_configs {
+					provider_name = "GCP"
+					region_name   = "NORTH_AMERICA_NORTHEAST_1"
+					priority      = 6
+
+					electable_specs {
+						instance_size = "M10"
+						node_count    = 2
+					}
+					read_only_specs {
+						instance_size = "M10"
+						node_count    = 1
+					}
+				}
+			}
+		}
+	`, projectID, name)
+}
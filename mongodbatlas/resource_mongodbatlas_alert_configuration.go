@@ -0,0 +1,555 @@
+package mongodbatlas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/mwielbut/pointy"
+	"github.com/spf13/cast"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+const (
+	alertConfigurationsPath       = "groups/%s/alertConfigs"
+	errorAlertConfigurationCreate = "error creating alert configuration in project (%s): %s"
+	errorAlertConfigurationRead   = "error getting alert configuration (%s): %s"
+	errorAlertConfigurationUpdate = "error updating alert configuration (%s): %s"
+	errorAlertConfigurationDelete = "error deleting alert configuration (%s): %s"
+)
+
+// alertMetricThresholdEventType is the only event type that alerts on a metric_threshold.
+const alertMetricThresholdEventType = "OUTSIDE_METRIC_THRESHOLD"
+
+var (
+	alertHostMatcherFields       = []string{"TYPE_NAME", "HOSTNAME", "PORT", "HOSTNAME_AND_PORT", "REPLICA_SET_NAME"}
+	alertReplicaSetMatcherFields = []string{"REPLICA_SET_NAME", "SHARD_NAME", "CLUSTER_NAME"}
+	alertClusterMatcherFields    = []string{"CLUSTER_NAME"}
+
+	// alertReplicaSetEventTypes are the event types of replica sets, the other types with matchers
+	// are recognized by their prefix, see alertMatcherFields.
+	alertReplicaSetEventTypes = map[string]bool{
+		"NO_PRIMARY":                           true,
+		"PRIMARY_ELECTED":                      true,
+		"TOO_MANY_ELECTIONS":                   true,
+		"TOO_FEW_HEALTHY_MEMBERS":              true,
+		"TOO_MANY_UNHEALTHY_MEMBERS":           true,
+		"REPLICATION_OPLOG_WINDOW_RUNNING_OUT": true,
+	}
+)
+
+// alertConfiguration represents an alert configuration of a project.
+// See more: https://docs.atlas.mongodb.com/reference/api/alert-configurations/
+type alertConfiguration struct {
+	ID              string                `json:"id,omitempty"`
+	GroupID         string                `json:"groupId,omitempty"`
+	EventTypeName   string                `json:"eventTypeName,omitempty"`
+	Enabled         *bool                 `json:"enabled,omitempty"`
+	Created         string                `json:"created,omitempty"`
+	Updated         string                `json:"updated,omitempty"`
+	Matchers        []*alertMatcher       `json:"matchers,omitempty"`
+	MetricThreshold *alertMetricThreshold `json:"metricThreshold,omitempty"`
+	Notifications   []*alertNotification  `json:"notifications,omitempty"`
+}
+
+type alertMatcher struct {
+	FieldName string `json:"fieldName,omitempty"`
+	Operator  string `json:"operator,omitempty"`
+	Value     string `json:"value,omitempty"`
+}
+
+type alertMetricThreshold struct {
+	MetricName string   `json:"metricName,omitempty"`
+	Operator   string   `json:"operator,omitempty"`
+	Threshold  *float64 `json:"threshold,omitempty"`
+	Units      string   `json:"units,omitempty"`
+	Mode       string   `json:"mode,omitempty"`
+}
+
+type alertNotification struct {
+	TypeName     string   `json:"typeName,omitempty"`
+	IntervalMin  int      `json:"intervalMin,omitempty"`
+	DelayMin     *int     `json:"delayMin,omitempty"`
+	EmailAddress string   `json:"emailAddress,omitempty"`
+	EmailEnabled *bool    `json:"emailEnabled,omitempty"`
+	SMSEnabled   *bool    `json:"smsEnabled,omitempty"`
+	MobileNumber string   `json:"mobileNumber,omitempty"`
+	Username     string   `json:"username,omitempty"`
+	TeamID       string   `json:"teamId,omitempty"`
+	ChannelName  string   `json:"channelName,omitempty"`
+	APIToken     string   `json:"apiToken,omitempty"`
+	Roles        []string `json:"roles,omitempty"`
+}
+
+func resourceMongoDBAtlasAlertConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMongoDBAtlasAlertConfigurationCreate,
+		Read:   resourceMongoDBAtlasAlertConfigurationRead,
+		Update: resourceMongoDBAtlasAlertConfigurationUpdate,
+		Delete: resourceMongoDBAtlasAlertConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMongoDBAtlasAlertConfigurationImportState,
+		},
+		CustomizeDiff: resourceMongoDBAtlasAlertConfigurationCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"alert_configuration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"event_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"matcher": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"operator": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"metric_threshold": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"operator": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"GREATER_THAN", "LESS_THAN"}, false),
+						},
+						"threshold": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+						"units": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"notification": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"interval_min": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"delay_min": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"email_address": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"email_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"sms_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"mobile_number": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"team_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"channel_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"api_token": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"roles": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceMongoDBAtlasAlertConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+
+	req, err := conn.NewRequest(context.Background(), http.MethodPost, fmt.Sprintf(alertConfigurationsPath, projectID), expandAlertConfiguration(d))
+	if err != nil {
+		return fmt.Errorf(errorAlertConfigurationCreate, projectID, err)
+	}
+
+	alert := new(alertConfiguration)
+	if _, err := conn.Do(context.Background(), req, alert); err != nil {
+		return fmt.Errorf(errorAlertConfigurationCreate, projectID, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id": projectID,
+		"id":         alert.ID,
+	}))
+
+	return resourceMongoDBAtlasAlertConfigurationRead(d, meta)
+}
+
+func resourceMongoDBAtlasAlertConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+
+	alert, resp, err := getAlertConfiguration(conn, ids["project_id"], ids["id"])
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(errorAlertConfigurationRead, ids["id"], err)
+	}
+
+	if err := d.Set("alert_configuration_id", alert.ID); err != nil {
+		return fmt.Errorf(errorAlertConfigurationRead, ids["id"], err)
+	}
+	if err := d.Set("event_type", alert.EventTypeName); err != nil {
+		return fmt.Errorf(errorAlertConfigurationRead, ids["id"], err)
+	}
+	if err := d.Set("enabled", alert.Enabled); err != nil {
+		return fmt.Errorf(errorAlertConfigurationRead, ids["id"], err)
+	}
+	if err := d.Set("created", alert.Created); err != nil {
+		return fmt.Errorf(errorAlertConfigurationRead, ids["id"], err)
+	}
+	if err := d.Set("updated", alert.Updated); err != nil {
+		return fmt.Errorf(errorAlertConfigurationRead, ids["id"], err)
+	}
+	if err := d.Set("matcher", flattenAlertMatchers(alert.Matchers)); err != nil {
+		return fmt.Errorf(errorAlertConfigurationRead, ids["id"], err)
+	}
+	if err := d.Set("metric_threshold", flattenAlertMetricThreshold(alert.MetricThreshold)); err != nil {
+		return fmt.Errorf(errorAlertConfigurationRead, ids["id"], err)
+	}
+	if err := d.Set("notification", flattenAlertNotifications(d, alert.Notifications)); err != nil {
+		return fmt.Errorf(errorAlertConfigurationRead, ids["id"], err)
+	}
+
+	return nil
+}
+
+func resourceMongoDBAtlasAlertConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	path := fmt.Sprintf(alertConfigurationsPath+"/%s", ids["project_id"], ids["id"])
+
+	// Toggling the alert has its own endpoint, the full update is only needed for other changes.
+	method := http.MethodPut
+	var body interface{} = expandAlertConfiguration(d)
+	if !d.HasChange("event_type") && !d.HasChange("matcher") && !d.HasChange("metric_threshold") && !d.HasChange("notification") {
+		method = http.MethodPatch
+		body = &alertConfiguration{Enabled: pointy.Bool(d.Get("enabled").(bool))}
+	}
+
+	req, err := conn.NewRequest(context.Background(), method, path, body)
+	if err != nil {
+		return fmt.Errorf(errorAlertConfigurationUpdate, ids["id"], err)
+	}
+	if _, err := conn.Do(context.Background(), req, nil); err != nil {
+		return fmt.Errorf(errorAlertConfigurationUpdate, ids["id"], err)
+	}
+
+	return resourceMongoDBAtlasAlertConfigurationRead(d, meta)
+}
+
+func resourceMongoDBAtlasAlertConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+
+	req, err := conn.NewRequest(context.Background(), http.MethodDelete, fmt.Sprintf(alertConfigurationsPath+"/%s", ids["project_id"], ids["id"]), nil)
+	if err != nil {
+		return fmt.Errorf(errorAlertConfigurationDelete, ids["id"], err)
+	}
+	if _, err := conn.Do(context.Background(), req, nil); err != nil {
+		return fmt.Errorf(errorAlertConfigurationDelete, ids["id"], err)
+	}
+	return nil
+}
+
+func resourceMongoDBAtlasAlertConfigurationImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*MongoDBClient).Atlas
+
+	parts := strings.SplitN(d.Id(), "-", 2)
+	if len(parts) != 2 {
+		return nil, errors.New("import format error: to import an alert configuration, use the format {project_id}-{alert_configuration_id}")
+	}
+
+	projectID := parts[0]
+	alertID := parts[1]
+
+	if _, _, err := getAlertConfiguration(conn, projectID, alertID); err != nil {
+		return nil, fmt.Errorf("couldn't import alert configuration %s in project %s, error: %s", alertID, projectID, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id": projectID,
+		"id":         alertID,
+	}))
+
+	if err := d.Set("project_id", projectID); err != nil {
+		log.Printf("[WARN] Error setting project_id for (%s): %s", d.Id(), err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func getAlertConfiguration(conn *matlas.Client, projectID, alertID string) (*alertConfiguration, *matlas.Response, error) {
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, fmt.Sprintf(alertConfigurationsPath+"/%s", projectID, alertID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(alertConfiguration)
+	resp, err := conn.Do(context.Background(), req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
+
+func resourceMongoDBAtlasAlertConfigurationCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("event_type") || !d.NewValueKnown("matcher") || !d.NewValueKnown("metric_threshold") {
+		return nil
+	}
+	return validateAlertConfiguration(d.Get("event_type").(string), d.Get("matcher").([]interface{}), d.Get("metric_threshold").([]interface{}))
+}
+
+// alertMatcherFields returns the matcher fields that Atlas accepts for the event type, and the kind
+// of target of its alerts. It returns false if the event type isn't known to target hosts, replica
+// sets or sharded clusters, its matchers are left to Atlas then.
+func alertMatcherFields(eventType string) ([]string, string, bool) {
+	switch {
+	case eventType == alertMetricThresholdEventType || strings.HasPrefix(eventType, "HOST_"):
+		return alertHostMatcherFields, "host", true
+	case alertReplicaSetEventTypes[eventType]:
+		return alertReplicaSetMatcherFields, "replica set", true
+	case strings.HasPrefix(eventType, "CLUSTER_"):
+		return alertClusterMatcherFields, "sharded cluster", true
+	}
+	return nil, "", false
+}
+
+// validateAlertConfiguration returns an error if the matchers or the metric threshold don't apply to
+// the event type, which Atlas rejects when the alert configuration is saved.
+func validateAlertConfiguration(eventType string, matchers, metricThreshold []interface{}) error {
+	hasMetricThreshold := len(metricThreshold) > 0 && metricThreshold[0] != nil
+	if eventType == alertMetricThresholdEventType && !hasMetricThreshold {
+		return fmt.Errorf("event_type %s requires a metric_threshold", eventType)
+	}
+	if eventType != alertMetricThresholdEventType && hasMetricThreshold {
+		return fmt.Errorf("metric_threshold can only be set when event_type is %s, not %s", alertMetricThresholdEventType, eventType)
+	}
+
+	fields, target, ok := alertMatcherFields(eventType)
+	if !ok {
+		return nil
+	}
+	for _, m := range matchers {
+		matcher, ok := m.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		fieldName := cast.ToString(matcher["field_name"])
+		valid := false
+		for _, field := range fields {
+			if fieldName == field {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return fmt.Errorf("matcher field_name %s doesn't apply to event_type %s, which alerts on a %s: use one of %s",
+				fieldName, eventType, target, strings.Join(fields, ", "))
+		}
+	}
+	return nil
+}
+
+func expandAlertConfiguration(d *schema.ResourceData) *alertConfiguration {
+	alert := &alertConfiguration{
+		EventTypeName: d.Get("event_type").(string),
+		Enabled:       pointy.Bool(d.Get("enabled").(bool)),
+		Matchers:      make([]*alertMatcher, 0),
+		Notifications: make([]*alertNotification, 0),
+	}
+
+	for _, m := range d.Get("matcher").([]interface{}) {
+		matcher := m.(map[string]interface{})
+		alert.Matchers = append(alert.Matchers, &alertMatcher{
+			FieldName: cast.ToString(matcher["field_name"]),
+			Operator:  cast.ToString(matcher["operator"]),
+			Value:     cast.ToString(matcher["value"]),
+		})
+	}
+
+	if l := d.Get("metric_threshold").([]interface{}); len(l) > 0 && l[0] != nil {
+		threshold := l[0].(map[string]interface{})
+		alert.MetricThreshold = &alertMetricThreshold{
+			MetricName: cast.ToString(threshold["metric_name"]),
+			Operator:   cast.ToString(threshold["operator"]),
+			Threshold:  pointy.Float64(cast.ToFloat64(threshold["threshold"])),
+			Units:      cast.ToString(threshold["units"]),
+			Mode:       cast.ToString(threshold["mode"]),
+		}
+	}
+
+	for _, n := range d.Get("notification").([]interface{}) {
+		notification := n.(map[string]interface{})
+
+		roles := make([]string, 0)
+		for _, r := range notification["roles"].([]interface{}) {
+			roles = append(roles, cast.ToString(r))
+		}
+
+		alert.Notifications = append(alert.Notifications, &alertNotification{
+			TypeName:     cast.ToString(notification["type_name"]),
+			IntervalMin:  cast.ToInt(notification["interval_min"]),
+			DelayMin:     pointy.Int(cast.ToInt(notification["delay_min"])),
+			EmailAddress: cast.ToString(notification["email_address"]),
+			EmailEnabled: pointy.Bool(cast.ToBool(notification["email_enabled"])),
+			SMSEnabled:   pointy.Bool(cast.ToBool(notification["sms_enabled"])),
+			MobileNumber: cast.ToString(notification["mobile_number"]),
+			Username:     cast.ToString(notification["username"]),
+			TeamID:       cast.ToString(notification["team_id"]),
+			ChannelName:  cast.ToString(notification["channel_name"]),
+			APIToken:     cast.ToString(notification["api_token"]),
+			Roles:        roles,
+		})
+	}
+
+	return alert
+}
+
+func flattenAlertMatchers(matchers []*alertMatcher) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0, len(matchers))
+	for _, matcher := range matchers {
+		results = append(results, map[string]interface{}{
+			"field_name": matcher.FieldName,
+			"operator":   matcher.Operator,
+			"value":      matcher.Value,
+		})
+	}
+	return results
+}
+
+func flattenAlertMetricThreshold(threshold *alertMetricThreshold) []map[string]interface{} {
+	if threshold == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"metric_name": threshold.MetricName,
+			"operator":    threshold.Operator,
+			"threshold":   threshold.Threshold,
+			"units":       threshold.Units,
+			"mode":        threshold.Mode,
+		},
+	}
+}
+
+func flattenAlertNotifications(d *schema.ResourceData, notifications []*alertNotification) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0, len(notifications))
+	for i, notification := range notifications {
+		result := map[string]interface{}{
+			"type_name":     notification.TypeName,
+			"interval_min":  notification.IntervalMin,
+			"delay_min":     notification.DelayMin,
+			"email_address": notification.EmailAddress,
+			"email_enabled": notification.EmailEnabled,
+			"sms_enabled":   notification.SMSEnabled,
+			"mobile_number": notification.MobileNumber,
+			"username":      notification.Username,
+			"team_id":       notification.TeamID,
+			"channel_name":  notification.ChannelName,
+			"roles":         notification.Roles,
+		}
+
+		// Atlas redacts the API tokens, keep the configured one.
+		result["api_token"] = d.Get(fmt.Sprintf("notification.%d.api_token", i))
+
+		results = append(results, result)
+	}
+	return results
+}
@@ -0,0 +1,274 @@
+package mongodbatlas
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+	"github.com/mwielbut/pointy"
+)
+
+func TestAccResourceMongoDBAtlasAlertConfiguration_basic(t *testing.T) {
+	var alertID string
+	resourceName := "mongodbatlas_alert_configuration.test"
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBAtlasAlertConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasAlertConfigurationConfig(projectID, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasAlertConfigurationExists(resourceName, &alertID),
+					resource.TestCheckResourceAttr(resourceName, "project_id", projectID),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "alert_configuration_id"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasAlertConfigurationConfig(projectID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					testAccCheckMongoDBAtlasAlertConfigurationIDUnchanged(resourceName, &alertID),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasAlertConfigurationConfig(projectID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					testAccCheckMongoDBAtlasAlertConfigurationIDUnchanged(resourceName, &alertID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceMongoDBAtlasAlertConfiguration_importBasic(t *testing.T) {
+	resourceName := "mongodbatlas_alert_configuration.test"
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBAtlasAlertConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasAlertConfigurationConfig(projectID, true),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccCheckMongoDBAtlasAlertConfigurationImportStateIDFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceMongoDBAtlasAlertConfigurationUpdate(t *testing.T) {
+	var methods []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			methods = append(methods, r.Method)
+		}
+		_ = json.NewEncoder(w).Encode(alertConfiguration{
+			ID:            "5d0f1f74cf09a29120e123cd",
+			EventTypeName: "OUTSIDE_METRIC_THRESHOLD",
+			Enabled:       pointy.Bool(false),
+		})
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	meta := &MongoDBClient{Atlas: client}
+
+	state := &terraform.InstanceState{
+		ID: encodeStateID(map[string]string{
+			"project_id": "5d09d6a59ccf6445652a444a",
+			"id":         "5d0f1f74cf09a29120e123cd",
+		}),
+		Attributes: map[string]string{
+			"project_id":               "5d09d6a59ccf6445652a444a",
+			"alert_configuration_id":   "5d0f1f74cf09a29120e123cd",
+			"event_type":               "OUTSIDE_METRIC_THRESHOLD",
+			"enabled":                  "true",
+			"notification.#":           "1",
+			"notification.0.type_name": "GROUP",
+		},
+	}
+
+	cases := []struct {
+		name     string
+		diff     map[string]*terraform.ResourceAttrDiff
+		expected string
+	}{
+		{
+			name:     "enabled only",
+			diff:     map[string]*terraform.ResourceAttrDiff{"enabled": {Old: "true", New: "false"}},
+			expected: http.MethodPatch,
+		},
+		{
+			name: "enabled and event type",
+			diff: map[string]*terraform.ResourceAttrDiff{
+				"enabled":    {Old: "true", New: "false"},
+				"event_type": {Old: "OUTSIDE_METRIC_THRESHOLD", New: "NO_PRIMARY"},
+			},
+			expected: http.MethodPut,
+		},
+	}
+
+	for _, tc := range cases {
+		methods = nil
+		updated, err := resourceMongoDBAtlasAlertConfiguration().Apply(state, &terraform.InstanceDiff{Attributes: tc.diff}, meta)
+		if err != nil {
+			t.Fatalf("%s: err: %s", tc.name, err)
+		}
+		if len(methods) != 1 || methods[0] != tc.expected {
+			t.Fatalf("%s: expected a single %s request, got %v", tc.name, tc.expected, methods)
+		}
+		if updated.ID != state.ID || updated.Attributes["alert_configuration_id"] != "5d0f1f74cf09a29120e123cd" {
+			t.Fatalf("%s: expected the alert configuration to keep its ID, got %s (%s)", tc.name, updated.ID, updated.Attributes["alert_configuration_id"])
+		}
+	}
+}
+
+func TestValidateAlertConfiguration(t *testing.T) {
+	matcher := func(fieldName string) []interface{} {
+		return []interface{}{map[string]interface{}{"field_name": fieldName, "operator": "EQUALS", "value": "test"}}
+	}
+	metricThreshold := []interface{}{map[string]interface{}{"metric_name": "ASSERT_REGULAR", "operator": "LESS_THAN", "threshold": 99.0}}
+
+	cases := []struct {
+		eventType       string
+		matchers        []interface{}
+		metricThreshold []interface{}
+		expectError     bool
+	}{
+		{eventType: "OUTSIDE_METRIC_THRESHOLD", matchers: matcher("HOSTNAME_AND_PORT"), metricThreshold: metricThreshold, expectError: false},
+		{eventType: "OUTSIDE_METRIC_THRESHOLD", expectError: true},
+		{eventType: "OUTSIDE_METRIC_THRESHOLD", matchers: matcher("CLUSTER_NAME"), metricThreshold: metricThreshold, expectError: true},
+		{eventType: "NO_PRIMARY", metricThreshold: metricThreshold, expectError: true},
+		{eventType: "NO_PRIMARY", matchers: matcher("REPLICA_SET_NAME"), expectError: false},
+		{eventType: "NO_PRIMARY", matchers: matcher("HOSTNAME"), expectError: true},
+		{eventType: "HOST_DOWN", matchers: matcher("TYPE_NAME"), expectError: false},
+		{eventType: "HOST_DOWN", matchers: matcher("SHARD_NAME"), expectError: true},
+		{eventType: "CLUSTER_MONGOS_IS_MISSING", matchers: matcher("CLUSTER_NAME"), expectError: false},
+		{eventType: "CLUSTER_MONGOS_IS_MISSING", matchers: matcher("PORT"), expectError: true},
+		{eventType: "USERS_WITHOUT_MULTI_FACTOR_AUTH", matchers: matcher("HOSTNAME"), expectError: false},
+	}
+
+	for _, c := range cases {
+		err := validateAlertConfiguration(c.eventType, c.matchers, c.metricThreshold)
+		if c.expectError && err == nil {
+			t.Fatalf("%s: expected error for matchers %v", c.eventType, c.matchers)
+		}
+		if !c.expectError && err != nil {
+			t.Fatalf("%s: unexpected error: %s", c.eventType, err)
+		}
+	}
+}
+
+func testAccCheckMongoDBAtlasAlertConfigurationExists(resourceName string, alertID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*MongoDBClient).Atlas
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		ids := decodeStateID(rs.Primary.ID)
+		if _, _, err := getAlertConfiguration(conn, ids["project_id"], ids["id"]); err != nil {
+			return fmt.Errorf("alert configuration (%s) does not exist", ids["id"])
+		}
+
+		*alertID = rs.Primary.Attributes["alert_configuration_id"]
+		return nil
+	}
+}
+
+func testAccCheckMongoDBAtlasAlertConfigurationIDUnchanged(resourceName string, alertID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if id := rs.Primary.Attributes["alert_configuration_id"]; id != *alertID {
+			return fmt.Errorf("expected alert_configuration_id to remain %s, got %s", *alertID, id)
+		}
+		return nil
+	}
+}
+
+func testAccCheckMongoDBAtlasAlertConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*MongoDBClient).Atlas
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongodbatlas_alert_configuration" {
+			continue
+		}
+
+		ids := decodeStateID(rs.Primary.ID)
+		if _, _, err := getAlertConfiguration(conn, ids["project_id"], ids["id"]); err == nil {
+			return fmt.Errorf("alert configuration (%s) still exists", ids["id"])
+		}
+	}
+	return nil
+}
+
+func testAccCheckMongoDBAtlasAlertConfigurationImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s-%s", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["alert_configuration_id"]), nil
+	}
+}
+
+func testAccMongoDBAtlasAlertConfigurationConfig(projectID string, enabled bool) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_alert_configuration" "test" {
+			project_id = "%s"
+			event_type = "OUTSIDE_METRIC_THRESHOLD"
+			enabled    = %t
+
+			matcher {
+				field_name = "HOSTNAME_AND_PORT"
+				operator   = "EQUALS"
+				value      = "SECONDARY"
+			}
+
+			metric_threshold {
+				metric_name = "ASSERT_REGULAR"
+				operator    = "LESS_THAN"
+				threshold   = 99.0
+				units       = "RAW"
+				mode        = "AVERAGE"
+			}
+
+			notification {
+				type_name     = "GROUP"
+				interval_min  = 5
+				delay_min     = 0
+				sms_enabled   = false
+				email_enabled = true
+			}
+		}
+	`, projectID, enabled)
+}
@@ -85,7 +85,7 @@ func resourceMongoDBAtlasCloudProviderSnapshot() *schema.Resource {
 
 func resourceMongoDBAtlasCloudProviderSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 	//Get client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 	ids := decodeStateID(d.Id())
 
 	requestParameters := &matlas.SnapshotReqPathParameters{
@@ -131,7 +131,7 @@ func resourceMongoDBAtlasCloudProviderSnapshotRead(d *schema.ResourceData, meta
 
 func resourceMongoDBAtlasCloudProviderSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
 	//Get client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 
 	requestParameters := &matlas.SnapshotReqPathParameters{
 		GroupID:     d.Get("project_id").(string),
@@ -175,7 +175,7 @@ func resourceMongoDBAtlasCloudProviderSnapshotCreate(d *schema.ResourceData, met
 
 func resourceMongoDBAtlasCloudProviderSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
 	//Get client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 	ids := decodeStateID(d.Id())
 
 	requestParameters := &matlas.SnapshotReqPathParameters{
@@ -215,7 +215,7 @@ func resourceCloudProviderSnapshotRefreshFunc(requestParameters *matlas.Snapshot
 }
 
 func resourceMongoDBAtlasCloudProviderSnapshotImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 
 	parts := strings.SplitN(d.Id(), "-", 3)
 	if len(parts) != 3 {
@@ -249,5 +249,30 @@ func resourceMongoDBAtlasCloudProviderSnapshotImportState(d *schema.ResourceData
 		log.Printf("[WARN] Error setting description for (%s): %s", d.Id(), err)
 	}
 
+	// Atlas doesn't return the retention of the snapshots, only when they expire.
+	retentionInDays, err := snapshotRetentionInDays(u.CreatedAt, u.ExpiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't import snapshot %s in project %s, error: %s", requestParameters.SnapshotID, requestParameters.GroupID, err)
+	}
+	if err := d.Set("retention_in_days", retentionInDays); err != nil {
+		log.Printf("[WARN] Error setting retention_in_days for (%s): %s", d.Id(), err)
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
+
+// snapshotRetentionInDays derives the retention of a snapshot from its creation and expiration
+// dates, rounded to the closest day since the snapshot is taken a bit after it's requested.
+func snapshotRetentionInDays(createdAt, expiresAt string) (int, error) {
+	created, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return 0, fmt.Errorf("invalid creation date %q: %s", createdAt, err)
+	}
+	expires, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return 0, fmt.Errorf("invalid expiration date %q: %s", expiresAt, err)
+	}
+
+	day := 24 * time.Hour
+	return int(expires.Sub(created).Round(day) / day), nil
+}
@@ -0,0 +1,362 @@
+package mongodbatlas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/mwielbut/pointy"
+	"github.com/spf13/cast"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+const (
+	cloudProviderSnapshotBackupPolicyPath = "groups/%s/clusters/%s/backup/schedule"
+	errorSnapshotBackupPolicyRead         = "error getting cloud provider snapshot backup policy for cluster (%s): %s"
+	errorSnapshotBackupPolicyUpdate       = "error updating cloud provider snapshot backup policy for cluster (%s): %s"
+)
+
+// cloudProviderSnapshotBackupPolicy represents the backup schedule of a cluster.
+// See more: https://docs.atlas.mongodb.com/reference/api/cloud-provider-snapshot-schedule/
+type cloudProviderSnapshotBackupPolicy struct {
+	ClusterID             string          `json:"clusterId,omitempty"`
+	ClusterName           string          `json:"clusterName,omitempty"`
+	ReferenceHourOfDay    *int64          `json:"referenceHourOfDay,omitempty"`
+	ReferenceMinuteOfHour *int64          `json:"referenceMinuteOfHour,omitempty"`
+	RestoreWindowDays     *int64          `json:"restoreWindowDays,omitempty"`
+	NextSnapshot          string          `json:"nextSnapshot,omitempty"`
+	CopySettings          []*copySettings `json:"copySettings"`
+	Policies              []*backupPolicy `json:"policies,omitempty"`
+}
+
+// backupPolicy is a set of rules that define when the snapshots of a cluster are taken and how
+// long they are retained.
+type backupPolicy struct {
+	ID          string              `json:"id,omitempty"`
+	PolicyItems []*backupPolicyItem `json:"policyItems,omitempty"`
+}
+
+type backupPolicyItem struct {
+	ID                string `json:"id,omitempty"`
+	FrequencyType     string `json:"frequencyType,omitempty"`
+	FrequencyInterval int64  `json:"frequencyInterval,omitempty"`
+	RetentionUnit     string `json:"retentionUnit,omitempty"`
+	RetentionValue    int64  `json:"retentionValue,omitempty"`
+}
+
+// copySettings represents a region where the snapshots of a cluster are copied to.
+type copySettings struct {
+	CloudProvider     string   `json:"cloudProvider,omitempty"`
+	RegionName        string   `json:"regionName,omitempty"`
+	ReplicationSpecID string   `json:"replicationSpecId,omitempty"`
+	ShouldCopyOplogs  *bool    `json:"shouldCopyOplogs,omitempty"`
+	Frequencies       []string `json:"frequencies,omitempty"`
+}
+
+func resourceMongoDBAtlasCloudProviderSnapshotBackupPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMongoDBAtlasCloudProviderSnapshotBackupPolicyCreate,
+		Read:   resourceMongoDBAtlasCloudProviderSnapshotBackupPolicyRead,
+		Update: resourceMongoDBAtlasCloudProviderSnapshotBackupPolicyUpdate,
+		Delete: resourceMongoDBAtlasCloudProviderSnapshotBackupPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMongoDBAtlasCloudProviderSnapshotBackupPolicyImportState,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"reference_hour_of_day": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 23),
+			},
+			"reference_minute_of_hour": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 59),
+			},
+			"restore_window_days": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"next_snapshot": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// copy_settings is a set, so the order in which Atlas returns the regions doesn't cause diffs.
+			"copy_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloud_provider": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"AWS", "GCP", "AZURE"}, false),
+						},
+						"region_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"replication_spec_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"should_copy_oplogs": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"frequencies": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"HOURLY", "DAILY", "WEEKLY", "MONTHLY", "ON_DEMAND",
+								}, false),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceMongoDBAtlasCloudProviderSnapshotBackupPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+	clusterName := d.Get("cluster_name").(string)
+
+	req := &cloudProviderSnapshotBackupPolicy{
+		CopySettings: expandCopySettings(d.Get("copy_settings").(*schema.Set).List()),
+	}
+	if v, ok := d.GetOkExists("reference_hour_of_day"); ok {
+		req.ReferenceHourOfDay = pointy.Int64(cast.ToInt64(v))
+	}
+	if v, ok := d.GetOkExists("reference_minute_of_hour"); ok {
+		req.ReferenceMinuteOfHour = pointy.Int64(cast.ToInt64(v))
+	}
+	if v, ok := d.GetOk("restore_window_days"); ok {
+		req.RestoreWindowDays = pointy.Int64(cast.ToInt64(v))
+	}
+
+	if _, _, err := updateCloudProviderSnapshotBackupPolicy(conn, projectID, clusterName, req); err != nil {
+		return fmt.Errorf(errorSnapshotBackupPolicyUpdate, clusterName, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":   projectID,
+		"cluster_name": clusterName,
+	}))
+
+	return resourceMongoDBAtlasCloudProviderSnapshotBackupPolicyRead(d, meta)
+}
+
+func resourceMongoDBAtlasCloudProviderSnapshotBackupPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	clusterName := ids["cluster_name"]
+
+	policy, _, err := getCloudProviderSnapshotBackupPolicy(conn, ids["project_id"], clusterName)
+	if err != nil {
+		return fmt.Errorf(errorSnapshotBackupPolicyRead, clusterName, err)
+	}
+
+	if err := d.Set("cluster_id", policy.ClusterID); err != nil {
+		return fmt.Errorf(errorSnapshotBackupPolicyRead, clusterName, err)
+	}
+	if err := d.Set("reference_hour_of_day", policy.ReferenceHourOfDay); err != nil {
+		return fmt.Errorf(errorSnapshotBackupPolicyRead, clusterName, err)
+	}
+	if err := d.Set("reference_minute_of_hour", policy.ReferenceMinuteOfHour); err != nil {
+		return fmt.Errorf(errorSnapshotBackupPolicyRead, clusterName, err)
+	}
+	if err := d.Set("restore_window_days", policy.RestoreWindowDays); err != nil {
+		return fmt.Errorf(errorSnapshotBackupPolicyRead, clusterName, err)
+	}
+	if err := d.Set("next_snapshot", policy.NextSnapshot); err != nil {
+		return fmt.Errorf(errorSnapshotBackupPolicyRead, clusterName, err)
+	}
+	if err := d.Set("copy_settings", flattenCopySettings(policy.CopySettings)); err != nil {
+		return fmt.Errorf(errorSnapshotBackupPolicyRead, clusterName, err)
+	}
+
+	return nil
+}
+
+func resourceMongoDBAtlasCloudProviderSnapshotBackupPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	clusterName := ids["cluster_name"]
+
+	req := &cloudProviderSnapshotBackupPolicy{
+		CopySettings: expandCopySettings(d.Get("copy_settings").(*schema.Set).List()),
+	}
+	if d.HasChange("reference_hour_of_day") {
+		req.ReferenceHourOfDay = pointy.Int64(cast.ToInt64(d.Get("reference_hour_of_day")))
+	}
+	if d.HasChange("reference_minute_of_hour") {
+		req.ReferenceMinuteOfHour = pointy.Int64(cast.ToInt64(d.Get("reference_minute_of_hour")))
+	}
+	if d.HasChange("restore_window_days") {
+		req.RestoreWindowDays = pointy.Int64(cast.ToInt64(d.Get("restore_window_days")))
+	}
+
+	if _, _, err := updateCloudProviderSnapshotBackupPolicy(conn, ids["project_id"], clusterName, req); err != nil {
+		return fmt.Errorf(errorSnapshotBackupPolicyUpdate, clusterName, err)
+	}
+
+	return resourceMongoDBAtlasCloudProviderSnapshotBackupPolicyRead(d, meta)
+}
+
+func resourceMongoDBAtlasCloudProviderSnapshotBackupPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	clusterName := ids["cluster_name"]
+
+	// The schedule can't be removed from the cluster, so stop copying the snapshots to other regions.
+	req := &cloudProviderSnapshotBackupPolicy{
+		CopySettings: []*copySettings{},
+	}
+
+	_, resp, err := updateCloudProviderSnapshotBackupPolicy(conn, ids["project_id"], clusterName, req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return fmt.Errorf(errorSnapshotBackupPolicyUpdate, clusterName, err)
+	}
+	return nil
+}
+
+func resourceMongoDBAtlasCloudProviderSnapshotBackupPolicyImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*MongoDBClient).Atlas
+
+	parts := strings.SplitN(d.Id(), "-", 2)
+	if len(parts) != 2 {
+		return nil, errors.New("import format error: to import a cloud provider snapshot backup policy, use the format {project_id}-{cluster_name}")
+	}
+
+	projectID := parts[0]
+	clusterName := parts[1]
+
+	_, _, err := getCloudProviderSnapshotBackupPolicy(conn, projectID, clusterName)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't import cloud provider snapshot backup policy for cluster %s in project %s, error: %s", clusterName, projectID, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":   projectID,
+		"cluster_name": clusterName,
+	}))
+
+	if err := d.Set("project_id", projectID); err != nil {
+		log.Printf("[WARN] Error setting project_id for (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("cluster_name", clusterName); err != nil {
+		log.Printf("[WARN] Error setting cluster_name for (%s): %s", d.Id(), err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandCopySettings(settings []interface{}) []*copySettings {
+	result := make([]*copySettings, 0, len(settings))
+
+	for _, s := range settings {
+		setting := s.(map[string]interface{})
+
+		frequencies := make([]string, 0)
+		for _, f := range setting["frequencies"].(*schema.Set).List() {
+			frequencies = append(frequencies, cast.ToString(f))
+		}
+
+		result = append(result, &copySettings{
+			CloudProvider:     cast.ToString(setting["cloud_provider"]),
+			RegionName:        cast.ToString(setting["region_name"]),
+			ReplicationSpecID: cast.ToString(setting["replication_spec_id"]),
+			ShouldCopyOplogs:  pointy.Bool(cast.ToBool(setting["should_copy_oplogs"])),
+			Frequencies:       frequencies,
+		})
+	}
+	return result
+}
+
+func flattenCopySettings(settings []*copySettings) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(settings))
+
+	for _, setting := range settings {
+		frequencies := make([]interface{}, 0, len(setting.Frequencies))
+		for _, f := range setting.Frequencies {
+			frequencies = append(frequencies, f)
+		}
+
+		result = append(result, map[string]interface{}{
+			"cloud_provider":      setting.CloudProvider,
+			"region_name":         setting.RegionName,
+			"replication_spec_id": setting.ReplicationSpecID,
+			"should_copy_oplogs":  setting.ShouldCopyOplogs,
+			"frequencies":         schema.NewSet(schema.HashString, frequencies),
+		})
+	}
+	return result
+}
+
+func getCloudProviderSnapshotBackupPolicy(conn *matlas.Client, projectID, clusterName string) (*cloudProviderSnapshotBackupPolicy, *matlas.Response, error) {
+	path := fmt.Sprintf(cloudProviderSnapshotBackupPolicyPath, projectID, clusterName)
+
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(cloudProviderSnapshotBackupPolicy)
+	resp, err := conn.Do(context.Background(), req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
+
+func updateCloudProviderSnapshotBackupPolicy(conn *matlas.Client, projectID, clusterName string, policy *cloudProviderSnapshotBackupPolicy) (*cloudProviderSnapshotBackupPolicy, *matlas.Response, error) {
+	path := fmt.Sprintf(cloudProviderSnapshotBackupPolicyPath, projectID, clusterName)
+
+	req, err := conn.NewRequest(context.Background(), http.MethodPatch, path, policy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(cloudProviderSnapshotBackupPolicy)
+	resp, err := conn.Do(context.Background(), req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
@@ -5,8 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 
 	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
@@ -20,6 +23,9 @@ func resourceMongoDBAtlasCloudProviderSnapshotRestoreJob() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceMongoDBAtlasCloudProviderSnapshotRestoreJobImportState,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Hour),
+		},
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:     schema.TypeString,
@@ -129,7 +135,7 @@ func resourceMongoDBAtlasCloudProviderSnapshotRestoreJob() *schema.Resource {
 
 func resourceMongoDBAtlasCloudProviderSnapshotRestoreJobCreate(d *schema.ResourceData, meta interface{}) error {
 	//Get client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 
 	requestParameters := &matlas.SnapshotReqPathParameters{
 		GroupID:     d.Get("project_id").(string),
@@ -159,12 +165,20 @@ func resourceMongoDBAtlasCloudProviderSnapshotRestoreJobCreate(d *schema.Resourc
 		"cluster_name":            d.Get("cluster_name").(string),
 	}))
 
+	// The download URLs are only available once Atlas has prepared the snapshot files.
+	if deliveryType == "download" {
+		requestParameters.JobID = cloudProviderSnapshotRestoreJob.ID
+		if err := waitForRestoreJobDeliveryURL(conn, requestParameters, d.Timeout(schema.TimeoutCreate), 30*time.Second); err != nil {
+			return fmt.Errorf("error waiting for the download URLs of cloudProviderSnapshotRestoreJob (%s): %s", cloudProviderSnapshotRestoreJob.ID, err)
+		}
+	}
+
 	return resourceMongoDBAtlasCloudProviderSnapshotRestoreJobRead(d, meta)
 }
 
 func resourceMongoDBAtlasCloudProviderSnapshotRestoreJobRead(d *schema.ResourceData, meta interface{}) error {
 	//Get client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 	ids := decodeStateID(d.Id())
 
 	requestParameters := &matlas.SnapshotReqPathParameters{
@@ -206,7 +220,7 @@ func resourceMongoDBAtlasCloudProviderSnapshotRestoreJobRead(d *schema.ResourceD
 }
 
 func resourceMongoDBAtlasCloudProviderSnapshotRestoreJobDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 	ids := decodeStateID(d.Id())
 
 	requestParameters := &matlas.SnapshotReqPathParameters{
@@ -228,7 +242,7 @@ func resourceMongoDBAtlasCloudProviderSnapshotRestoreJobDelete(d *schema.Resourc
 }
 
 func resourceMongoDBAtlasCloudProviderSnapshotRestoreJobImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 
 	parts := strings.SplitN(d.Id(), "-", 3)
 	if len(parts) != 3 {
@@ -277,3 +291,62 @@ func resourceMongoDBAtlasCloudProviderSnapshotRestoreJobImportState(d *schema.Re
 
 	return []*schema.ResourceData{d}, nil
 }
+
+const cloudProviderSnapshotRestoreJobPath = "groups/%s/clusters/%s/backup/restoreJobs/%s"
+
+// cloudProviderSnapshotRestoreJob adds the fields missing in the client to a restore job.
+type cloudProviderSnapshotRestoreJob struct {
+	matlas.CloudProviderSnapshotRestoreJob
+	Failed *bool `json:"failed,omitempty"`
+}
+
+func getCloudProviderSnapshotRestoreJob(conn *matlas.Client, requestParameters *matlas.SnapshotReqPathParameters) (*cloudProviderSnapshotRestoreJob, error) {
+	path := fmt.Sprintf(cloudProviderSnapshotRestoreJobPath, requestParameters.GroupID, requestParameters.ClusterName, requestParameters.JobID)
+
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	root := new(cloudProviderSnapshotRestoreJob)
+	if _, err := conn.Do(context.Background(), req, root); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+func waitForRestoreJobDeliveryURL(conn *matlas.Client, requestParameters *matlas.SnapshotReqPathParameters, timeout, minTimeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pending"},
+		Target:     []string{"ready"},
+		Refresh:    resourceCloudProviderSnapshotRestoreJobDeliveryURLRefreshFunc(conn, requestParameters),
+		Timeout:    timeout,
+		MinTimeout: minTimeout,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func resourceCloudProviderSnapshotRestoreJobDeliveryURLRefreshFunc(conn *matlas.Client, requestParameters *matlas.SnapshotReqPathParameters) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		job, err := getCloudProviderSnapshotRestoreJob(conn, requestParameters)
+		if err != nil {
+			return nil, "", err
+		}
+
+		switch {
+		case job.Failed != nil && *job.Failed:
+			return nil, "", fmt.Errorf("the restore job (%s) failed", job.ID)
+		case job.Cancelled:
+			return nil, "", fmt.Errorf("the restore job (%s) was cancelled", job.ID)
+		case job.Expired:
+			return nil, "", fmt.Errorf("the restore job (%s) expired", job.ID)
+		case len(job.DeliveryURL) > 0:
+			return job, "ready", nil
+		}
+
+		log.Printf("[DEBUG] waiting for the download URLs of the restore job: %s", job.ID)
+		return job, "pending", nil
+	}
+}
@@ -2,10 +2,14 @@ package mongodbatlas
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
@@ -81,9 +85,74 @@ func TestAccResourceMongoDBAtlasCloudProviderSnapshotRestoreJob_importBasic(t *t
 	})
 }
 
+func TestWaitForRestoreJobDeliveryURL(t *testing.T) {
+	requestParameters := &matlas.SnapshotReqPathParameters{
+		GroupID:     "5d09d6a59ccf6445652a444a",
+		ClusterName: "test",
+		JobID:       "5cf5a5cfcf09a2ae1a5f7bcd",
+	}
+
+	cases := []struct {
+		name        string
+		job         func(poll int) cloudProviderSnapshotRestoreJob
+		expectError bool
+		polls       int
+	}{
+		{
+			name: "delivery url populated on the third poll",
+			job: func(poll int) cloudProviderSnapshotRestoreJob {
+				job := cloudProviderSnapshotRestoreJob{}
+				job.ID = requestParameters.JobID
+				if poll == 3 {
+					job.DeliveryURL = []string{"https://restore.example.com/shard-0.tar.gz", "https://restore.example.com/shard-1.tar.gz"}
+				}
+				return job
+			},
+			polls: 3,
+		},
+		{
+			name: "job failed",
+			job: func(poll int) cloudProviderSnapshotRestoreJob {
+				failed := poll == 2
+				job := cloudProviderSnapshotRestoreJob{Failed: &failed}
+				job.ID = requestParameters.JobID
+				return job
+			},
+			expectError: true,
+			polls:       2,
+		},
+	}
+
+	for _, c := range cases {
+		polls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			polls++
+			_ = json.NewEncoder(w).Encode(c.job(polls))
+		}))
+
+		client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		err = waitForRestoreJobDeliveryURL(client, requestParameters, time.Minute, time.Millisecond)
+		server.Close()
+
+		if c.expectError && err == nil {
+			t.Fatalf("%s: expected error", c.name)
+		}
+		if !c.expectError && err != nil {
+			t.Fatalf("%s: unexpected error: %s", c.name, err)
+		}
+		if polls != c.polls {
+			t.Fatalf("%s: expected %d polls, got %d", c.name, c.polls, polls)
+		}
+	}
+}
+
 func testAccCheckMongoDBAtlasCloudProviderSnapshotRestoreJobExists(resourceName string, cloudProviderSnapshotRestoreJob *matlas.CloudProviderSnapshotRestoreJob) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*matlas.Client)
+		conn := testAccProvider.Meta().(*MongoDBClient).Atlas
 
 		rs, ok := s.RootModule().Resources[resourceName]
 		if !ok {
@@ -119,7 +188,7 @@ func testAccCheckMongoDBAtlasCloudProviderSnapshotRestoreJobAttributes(cloudProv
 }
 
 func testAccCheckMongoDBAtlasCloudProviderSnapshotRestoreJobDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*matlas.Client)
+	conn := testAccProvider.Meta().(*MongoDBClient).Atlas
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "mongodbatlas_cloud_provider_snapshot_restore_job" {
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
@@ -61,19 +63,47 @@ func TestAccResourceMongoDBAtlasCloudProviderSnapshot_importBasic(t *testing.T)
 				Config: testAccMongoDBAtlasCloudProviderSnapshotConfig(projectID, clusterName, description, retentionInDays),
 			},
 			{
-				ResourceName:            resourceName,
-				ImportStateIdFunc:       testAccCheckMongoDBAtlasCloudProviderSnapshotImportStateIDFunc(resourceName),
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"retention_in_days"},
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccCheckMongoDBAtlasCloudProviderSnapshotImportStateIDFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
 			},
 		},
 	})
 }
 
+func TestResourceMongoDBAtlasCloudProviderSnapshotImportState(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/groups/5d09d6a59ccf6445652a444a/clusters/cluster0/backup/snapshots/5d1285acd5ec13b6c2d1726a" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"id": "5d1285acd5ec13b6c2d1726a", "description": "My description", "createdAt": "2020-03-01T10:02:11Z", "expiresAt": "2020-03-08T10:00:00Z", "status": "completed"}`)
+	}))
+	defer server.Close()
+
+	atlas, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	d := resourceMongoDBAtlasCloudProviderSnapshot().TestResourceData()
+	d.SetId("5d09d6a59ccf6445652a444a-cluster0-5d1285acd5ec13b6c2d1726a")
+
+	if _, err := resourceMongoDBAtlasCloudProviderSnapshotImportState(d, &MongoDBClient{Atlas: atlas}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if retentionInDays := d.Get("retention_in_days").(int); retentionInDays != 7 {
+		t.Fatalf("expected retention_in_days 7, got %d", retentionInDays)
+	}
+
+	if _, err := snapshotRetentionInDays("2020-03-01T10:02:11Z", ""); err == nil {
+		t.Fatal("expected an error without expiration date")
+	}
+}
+
 func testAccCheckMongoDBAtlasCloudProviderSnapshotExists(resourceName string, cloudProviderSnapshot *matlas.CloudProviderSnapshot) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*matlas.Client)
+		conn := testAccProvider.Meta().(*MongoDBClient).Atlas
 
 		rs, ok := s.RootModule().Resources[resourceName]
 		if !ok {
@@ -110,7 +140,7 @@ func testAccCheckMongoDBAtlasCloudProviderSnapshotAttributes(cloudProviderSnapsh
 }
 
 func testAccCheckMongoDBAtlasCloudProviderSnapshotDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*matlas.Client)
+	conn := testAccProvider.Meta().(*MongoDBClient).Atlas
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "mongodbatlas_cloud_provider_snapshot" {
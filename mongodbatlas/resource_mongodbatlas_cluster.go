@@ -5,8 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
+	"net/url"
+	"os"
 	"reflect"
+	"regexp"
+	"sort"
 	"time"
 
 	"strconv"
@@ -14,6 +19,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 
 	"github.com/mwielbut/pointy"
 	"github.com/spf13/cast"
@@ -26,6 +32,8 @@ const (
 	errorRead   = "error reading MongoDB Cluster (%s): %s"
 	errorDelete = "error deleting MongoDB Cluster (%s): %s"
 	errorUpdate = "error updating MongoDB Cluster (%s): %s"
+
+	defaultZoneName = "ZoneName managed by Terraform"
 )
 
 func resourceMongoDBAtlasCluster() *schema.Resource {
@@ -37,6 +45,10 @@ func resourceMongoDBAtlasCluster() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceMongoDBAtlasClusterImportState,
 		},
+		CustomizeDiff: resourceMongoDBAtlasClusterCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(3 * time.Hour),
+		},
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:     schema.TypeString,
@@ -58,9 +70,11 @@ func resourceMongoDBAtlasCluster() *schema.Resource {
 				Default:  false,
 			},
 			"bi_connector": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateBiConnector,
+				DiffSuppressFunc: biConnectorDiffSuppressFunc,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"enabled": {
@@ -82,8 +96,13 @@ func resourceMongoDBAtlasCluster() *schema.Resource {
 				Computed: true,
 			},
 			"disk_size_gb": {
+				Type:             schema.TypeFloat,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: diskSizeGBDiffSuppressFunc,
+			},
+			"disk_size_gb_limit": {
 				Type:     schema.TypeFloat,
-				Optional: true,
 				Computed: true,
 			},
 			"encryption_at_rest_provider": {
@@ -96,9 +115,16 @@ func resourceMongoDBAtlasCluster() *schema.Resource {
 				Required: true,
 			},
 			"mongo_db_major_version": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: mongoDBMajorVersionDiffSuppressFunc,
+			},
+			"version_release_system": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"LTS", clusterContinuousReleaseSystem}, false),
 			},
 			"num_shards": {
 				Type:     schema.TypeInt,
@@ -124,9 +150,10 @@ func resourceMongoDBAtlasCluster() *schema.Resource {
 				Computed: true,
 			},
 			"provider_disk_iops": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: providerDiskIOPSDiffSuppressFunc,
 			},
 			"provider_disk_type_name": {
 				Type:     schema.TypeString,
@@ -139,9 +166,11 @@ func resourceMongoDBAtlasCluster() *schema.Resource {
 				Computed: true,
 			},
 			"provider_region_name": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateClusterProviderRegionName,
+				DiffSuppressFunc: clusterRegionNameDiffSuppressFunc,
 			},
 			"provider_volume_type": {
 				Type:     schema.TypeString,
@@ -153,6 +182,30 @@ func resourceMongoDBAtlasCluster() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"total_electable_nodes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"total_read_only_nodes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"total_analytics_nodes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"regions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 1,
+				MaxItems: 7,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateClusterProviderRegionName,
+				},
+				DiffSuppressFunc: clusterRegionNameDiffSuppressFunc,
+				ConflictsWith:    []string{"replication_specs", "provider_region_name"},
+			},
 			"replication_specs": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -172,40 +225,13 @@ func resourceMongoDBAtlasCluster() *schema.Resource {
 							Type:     schema.TypeSet,
 							Optional: true,
 							Computed: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"region_name": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Computed: true,
-									},
-									"electable_nodes": {
-										Type:     schema.TypeInt,
-										Optional: true,
-										Computed: true,
-									},
-									"priority": {
-										Type:     schema.TypeInt,
-										Optional: true,
-										Computed: true,
-									},
-									"read_only_nodes": {
-										Type:     schema.TypeInt,
-										Optional: true,
-										Default:  0,
-									},
-									"analytics_nodes": {
-										Type:     schema.TypeInt,
-										Optional: true,
-										Default:  0,
-									},
-								},
-							},
+							Set:      regionsConfigHash,
+							Elem:     regionsConfigSchema(),
 						},
 						"zone_name": {
 							Type:     schema.TypeString,
 							Optional: true,
-							Default:  "ZoneName managed by Terraform",
+							Default:  defaultZoneName,
 						},
 					},
 				},
@@ -214,7 +240,34 @@ func resourceMongoDBAtlasCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"create_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"mongo_uri": {
+				Type:       schema.TypeString,
+				Computed:   true,
+				Deprecated: "use mongo_uri_standard or mongo_uri_private instead",
+			},
+			"mongo_uri_standard": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hosts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"process_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"mongo_uri_private": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
@@ -226,10 +279,161 @@ func resourceMongoDBAtlasCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"redact_client_log_data": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"root_cert_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(clusterRootCertTypes, false),
+			},
+			"replica_set_scaling_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(clusterReplicaSetScalingStrategies, false),
+			},
+			"tags": resourceTagsSchema(),
+			"labels": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateClusterLabelKey,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"config_server_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pinned_fcv": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"expiration_date": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: rfc3339DiffSuppressFunc,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"advanced_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_read_concern": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"default_write_concern": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"fail_index_key_too_long": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"javascript_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"minimum_enabled_tls_protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"TLS1_0", "TLS1_1", "TLS1_2"}, false),
+						},
+						"no_table_scan": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"oplog_size_mb": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"sample_size_bi_connector": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"sample_refresh_interval_bi_connector": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"mongo_uri_options": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateMongoURIOptions,
+			},
+			"mongo_uri_custom": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"paused": {
 				Type:     schema.TypeBool,
+				Optional: true,
 				Computed: true,
 			},
+			"accept_data_risks_and_force_replica_set_reconfig": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.ValidateRFC3339TimeString,
+				DiffSuppressFunc: rfc3339DiffSuppressFunc,
+			},
+			"wait_for_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"dependents_on_delete": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      clusterDependentsWarn,
+				ValidateFunc: validation.StringInSlice([]string{clusterDependentsIgnore, clusterDependentsWarn, clusterDependentsFail}, false),
+			},
+			"wait_for_mongo_uri_update": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"allow_analytics_only_regions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"srv_address": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -244,9 +448,18 @@ func resourceMongoDBAtlasCluster() *schema.Resource {
 
 func resourceMongoDBAtlasClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	//Get client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 	projectID := d.Get("project_id").(string)
 
+	//The replication specs generated from the regions are always of a single zone.
+	if _, ok := d.GetOk("regions"); ok {
+		if _, ok1 := d.GetOk("cluster_type"); !ok1 {
+			if err := d.Set("cluster_type", "REPLICASET"); err != nil {
+				return fmt.Errorf(errorCreate, err)
+			}
+		}
+	}
+
 	//validate cluster_type conditional
 	if _, ok := d.GetOk("replication_specs"); ok {
 		if _, ok1 := d.GetOk("cluster_type"); !ok1 {
@@ -297,15 +510,34 @@ func resourceMongoDBAtlasClusterCreate(d *schema.ResourceData, meta interface{})
 		clusterRequest.NumShards = pointy.Int64(cast.ToInt64(n))
 	}
 
-	cluster, _, err := conn.Clusters.Create(context.Background(), projectID, clusterRequest)
-	if err != nil {
-		return fmt.Errorf(errorCreate, err)
+	var clusterID string
+	refreshFunc := resourceClusterRefreshFunc(d.Get("name").(string), projectID, conn)
+
+	// Regions spanning several cloud providers can only be expressed with the advanced clusters API.
+	if isMultiCloudCluster(d) {
+		advancedRequest, err := expandAdvancedClusterFromCluster(d, clusterRequest)
+		if err != nil {
+			return fmt.Errorf(errorCreate, err)
+		}
+
+		cluster, _, err := createAdvancedCluster(conn, projectID, advancedRequest)
+		if err != nil {
+			return fmt.Errorf(errorCreate, err)
+		}
+		clusterID = cluster.ID
+		refreshFunc = advancedClusterRefreshFunc(d.Get("name").(string), projectID, conn)
+	} else {
+		cluster, _, err := conn.Clusters.Create(context.Background(), projectID, clusterRequest)
+		if err != nil {
+			return fmt.Errorf(errorCreate, err)
+		}
+		clusterID = cluster.ID
 	}
 
 	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"CREATING", "UPDATING", "REPAIRING", "REPEATING"},
+		Pending:    []string{"CREATING", "UPDATING", "REPAIRING", "REPEATING", "PENDING"},
 		Target:     []string{"IDLE"},
-		Refresh:    resourceClusterRefreshFunc(d.Get("name").(string), projectID, conn),
+		Refresh:    refreshFunc,
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 		MinTimeout: 30 * time.Second,
 		Delay:      1 * time.Minute,
@@ -317,10 +549,91 @@ func resourceMongoDBAtlasClusterCreate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf(errorCreate, err)
 	}
 
+	//Log redaction can only be enabled once the cluster is running.
+	if v, ok := d.GetOk("redact_client_log_data"); ok && v.(bool) {
+		if err := updateClusterRedactClientLogData(conn, projectID, d.Get("name").(string), true); err != nil {
+			return fmt.Errorf(errorCreate, err)
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf(errorCreate, err)
+		}
+	}
+
+	//The client doesn't send the release system on creation.
+	if v, ok := d.GetOk("version_release_system"); ok && v.(string) == clusterContinuousReleaseSystem {
+		if err := updateClusterVersionReleaseSystem(conn, projectID, d.Get("name").(string), v.(string)); err != nil {
+			return fmt.Errorf(errorCreate, err)
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf(errorCreate, err)
+		}
+	}
+
+	//The client doesn't send the root certificate on creation.
+	if v, ok := d.GetOk("root_cert_type"); ok && v.(string) != defaultClusterRootCertType {
+		if err := updateClusterRootCertType(conn, projectID, d.Get("name").(string), v.(string)); err != nil {
+			return fmt.Errorf(errorCreate, err)
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf(errorCreate, err)
+		}
+	}
+
+	if v, ok := d.GetOk("replica_set_scaling_strategy"); ok {
+		if err := updateClusterReplicaSetScalingStrategy(conn, projectID, d.Get("name").(string), v.(string)); err != nil {
+			return fmt.Errorf(errorCreate, err)
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf(errorCreate, err)
+		}
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		if err := updateClusterTags(conn, projectID, d.Get("name").(string), expandResourceTags(v.(*schema.Set))); err != nil {
+			return fmt.Errorf(errorCreate, err)
+		}
+	}
+
+	if v, ok := d.GetOk("labels"); ok {
+		if err := updateClusterLabels(conn, projectID, d.Get("name").(string), expandClusterLabels(v.(*schema.Set))); err != nil {
+			return fmt.Errorf(errorCreate, err)
+		}
+	}
+
+	if _, ok := d.GetOk("advanced_configuration"); ok {
+		if _, err := updateClusterProcessArgs(conn, projectID, d.Get("name").(string), expandProcessArgs(d)); err != nil {
+			return fmt.Errorf(errorCreate, err)
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf(errorCreate, err)
+		}
+	}
+
+	if v, ok := d.GetOk("pinned_fcv.0.expiration_date"); ok {
+		if err := pinClusterFCV(conn, projectID, d.Get("name").(string), v.(string)); err != nil {
+			return fmt.Errorf(errorCreate, err)
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf(errorCreate, err)
+		}
+	}
+
+	//The processes of a new cluster are registered shortly after it's IDLE.
+	refresh := clusterProcessIDsRefreshFunc(func() ([]string, error) {
+		mongoURI, err := getClusterMongoURI(conn, projectID, d.Get("name").(string), isMultiCloudCluster(d))
+		if err != nil {
+			return nil, err
+		}
+		return getClusterProcessIDs(conn, projectID, mongoURI)
+	})
+	if err := waitForClusterProcesses(refresh, clusterProcessesTimeout, 10*time.Second); err != nil {
+		log.Printf("[WARN] %s, `process_ids` of cluster (%s) will be set on the next refresh", err, d.Get("name").(string))
+	}
+
 	d.SetId(encodeStateID(map[string]string{
-		"cluster_id":   cluster.ID,
+		"cluster_id":   clusterID,
 		"project_id":   projectID,
-		"cluster_name": cluster.Name,
+		"cluster_name": d.Get("name").(string),
 	}))
 
 	return resourceMongoDBAtlasClusterRead(d, meta)
@@ -328,17 +641,32 @@ func resourceMongoDBAtlasClusterCreate(d *schema.ResourceData, meta interface{})
 
 func resourceMongoDBAtlasClusterRead(d *schema.ResourceData, meta interface{}) error {
 	//Get client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 	ids := decodeStateID(d.Id())
 	projectID := ids["project_id"]
 	clusterName := ids["cluster_name"]
 
-	cluster, resp, err := conn.Clusters.Get(context.Background(), projectID, clusterName)
+	if isMultiCloudCluster(d) {
+		return resourceMongoDBAtlasClusterReadAdvanced(d, conn, projectID, clusterName)
+	}
+
+	cluster, resp, err := getClusterWithExtraFields(conn, projectID, clusterName)
 	if err != nil {
+		if isProjectNotFoundError(err) {
+			log.Printf("[WARN] the project (%s) of cluster (%s) no longer exists, removing the cluster from the state", projectID, clusterName)
+			d.SetId("")
+			return nil
+		}
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
 
 			return nil
 		}
+		// Multi-cloud clusters can't be read with the legacy API, e.g. when they are imported.
+		if resp != nil && resp.StatusCode == http.StatusBadRequest {
+			if errAdvanced := resourceMongoDBAtlasClusterReadAdvanced(d, conn, projectID, clusterName); errAdvanced == nil {
+				return nil
+			}
+		}
 		return fmt.Errorf(errorRead, clusterName, err)
 	}
 
@@ -360,6 +688,12 @@ func resourceMongoDBAtlasClusterRead(d *schema.ResourceData, meta interface{}) e
 	if err := d.Set("disk_size_gb", cluster.DiskSizeGB); err != nil {
 		return fmt.Errorf(errorRead, clusterName, err)
 	}
+	if cluster.ProviderSettings != nil && cluster.DiskSizeGB != nil {
+		limit := clusterDiskSizeGBLimit(cluster.ProviderSettings.InstanceSizeName, cluster.AutoScaling.DiskGBEnabled != nil && *cluster.AutoScaling.DiskGBEnabled, *cluster.DiskSizeGB)
+		if err := d.Set("disk_size_gb_limit", limit); err != nil {
+			return fmt.Errorf(errorRead, clusterName, err)
+		}
+	}
 	if err := d.Set("encryption_at_rest_provider", cluster.EncryptionAtRestProvider); err != nil {
 		return fmt.Errorf(errorRead, clusterName, err)
 	}
@@ -374,18 +708,35 @@ func resourceMongoDBAtlasClusterRead(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	if err := d.Set("mongo_db_version", cluster.MongoDBVersion); err != nil {
-		return fmt.Errorf(errorRead, clusterName, err)
+	//The version and creation date are empty while the cluster is being created.
+	if cluster.MongoDBVersion != "" {
+		if err := d.Set("mongo_db_version", cluster.MongoDBVersion); err != nil {
+			return fmt.Errorf(errorRead, clusterName, err)
+		}
 	}
 	if err := d.Set("mongo_uri", cluster.MongoURI); err != nil {
 		return fmt.Errorf(errorRead, clusterName, err)
 	}
-	if err := d.Set("mongo_uri_updated", cluster.MongoURIUpdated); err != nil {
+	if err := d.Set("hosts", mongoURIHosts(cluster.MongoURI)); err != nil {
+		return fmt.Errorf(errorRead, clusterName, err)
+	}
+	if err := setClusterProcessIDs(d, conn, projectID, cluster.MongoURI); err != nil {
+		return fmt.Errorf(errorRead, clusterName, err)
+	}
+	if err := d.Set("mongo_uri_updated", normalizeMongoURIUpdated(cluster.MongoURIUpdated)); err != nil {
 		return fmt.Errorf(errorRead, clusterName, err)
 	}
 	if err := d.Set("mongo_uri_with_options", cluster.MongoURIWithOptions); err != nil {
 		return fmt.Errorf(errorRead, clusterName, err)
 	}
+
+	mongoURICustom, err := composeMongoURI(cluster.MongoURIWithOptions, d.Get("mongo_uri_options").(map[string]interface{}))
+	if err != nil {
+		return fmt.Errorf(errorRead, clusterName, err)
+	}
+	if err := d.Set("mongo_uri_custom", mongoURICustom); err != nil {
+		return fmt.Errorf(errorRead, clusterName, err)
+	}
 	if err := d.Set("paused", cluster.Paused); err != nil {
 		return fmt.Errorf(errorRead, clusterName, err)
 	}
@@ -404,19 +755,67 @@ func resourceMongoDBAtlasClusterRead(d *schema.ResourceData, meta interface{}) e
 	if err := d.Set("replication_specs", flattenReplicationSpecs(cluster.ReplicationSpecs)); err != nil {
 		return fmt.Errorf(errorRead, clusterName, err)
 	}
+	if err := setClusterNodeCounts(d, countReplicationSpecsNodes(cluster.ReplicationSpecs)); err != nil {
+		return fmt.Errorf(errorRead, clusterName, err)
+	}
 	if err := d.Set("replication_factor", cluster.ReplicationFactor); err != nil {
 		return fmt.Errorf(errorRead, clusterName, err)
 	}
 
+	if err := d.Set("redact_client_log_data", cluster.RedactClientLogData); err != nil {
+		return fmt.Errorf(errorRead, clusterName, err)
+	}
+	if err := d.Set("root_cert_type", cluster.RootCertType); err != nil {
+		return fmt.Errorf(errorRead, clusterName, err)
+	}
+	if err := d.Set("labels", flattenClusterLabelsSet(cluster.Labels)); err != nil {
+		return fmt.Errorf(errorRead, clusterName, err)
+	}
+	if err := d.Set("version_release_system", cluster.VersionReleaseSystem); err != nil {
+		return fmt.Errorf(errorRead, clusterName, err)
+	}
+	if cluster.CreateDate != "" {
+		if err := d.Set("create_date", cluster.CreateDate); err != nil {
+			return fmt.Errorf(errorRead, clusterName, err)
+		}
+	}
+	if err := setClusterConnectionStrings(d, cluster.ConnectionStrings); err != nil {
+		return fmt.Errorf(errorRead, clusterName, err)
+	}
+	if err := setClusterV2Fields(d, conn, projectID, clusterName); err != nil {
+		return fmt.Errorf(errorRead, clusterName, err)
+	}
+	if err := setClusterAdvancedConfiguration(d, conn, projectID, clusterName); err != nil {
+		return fmt.Errorf(errorRead, clusterName, err)
+	}
+
 	return nil
 }
 
 func resourceMongoDBAtlasClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := applyClusterUpdate(d, meta)
+	if err == nil || !hasClusterTopologyChange(d) {
+		return err
+	}
+
+	//A failed update saves the configured topology in the state, although Atlas may have applied
+	//only part of it, e.g. when the wait of a multi-region scale is interrupted. The topology is
+	//read back so that the next plan computes the delta from the actual cluster.
+	ids := decodeStateID(d.Id())
+	if rErr := resetClusterTopology(d, meta.(*MongoDBClient).Atlas, ids["project_id"], ids["cluster_name"]); rErr != nil {
+		log.Printf("[WARN] Error reading the topology of cluster (%s) after a failed update: %s", ids["cluster_name"], rErr)
+	}
+	return err
+}
+
+func applyClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 	//Get client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 	ids := decodeStateID(d.Id())
 	projectID := ids["project_id"]
 	clusterName := ids["cluster_name"]
+	retry := meta.(*MongoDBClient).retryOnSnapshotInProgress
+	timeout := d.Timeout(schema.TimeoutUpdate)
 
 	cluster := new(matlas.Cluster)
 
@@ -440,12 +839,21 @@ func resourceMongoDBAtlasClusterUpdate(d *schema.ResourceData, meta interface{})
 		cluster.ProviderSettings = &providerSettings
 	}
 
-	if d.HasChange("replication_specs") {
+	var specsRemoval []matlas.ReplicationSpec
+	var removedZones []string
+	if d.HasChange("replication_specs") || d.HasChange("regions") || (d.HasChange("replication_factor") && len(d.Get("regions").([]interface{})) > 0) {
 		replicationSpecs, err := expandReplicationSpecs(d)
 		if err != nil {
 			return fmt.Errorf(errorUpdate, clusterName, err)
 		}
 		cluster.ReplicationSpecs = replicationSpecs
+
+		old, _ := d.GetChange("replication_specs")
+		priorSpecs, _ := old.([]interface{})
+		specsRemoval, removedZones, err = replicationSpecsRemoval(priorSpecs, replicationSpecs)
+		if err != nil {
+			return fmt.Errorf(errorUpdate, clusterName, err)
+		}
 	}
 
 	if d.HasChange("auto_scaling_disk_gb_enabled") {
@@ -476,51 +884,530 @@ func resourceMongoDBAtlasClusterUpdate(d *schema.ResourceData, meta interface{})
 		cluster.NumShards = pointy.Int64(cast.ToInt64(d.Get("num_shards")))
 	}
 
-	// Has changes
-	if !reflect.DeepEqual(cluster, matlas.Cluster{}) {
-		_, _, err := conn.Clusters.Update(context.Background(), projectID, clusterName, cluster)
-		if err != nil {
-			return fmt.Errorf(errorUpdate, clusterName, err)
-		}
+	//Removing shards moves their data to the remaining ones, which keeps the cluster UPDATING for
+	//much longer than adding shards.
+	oldNumShards, newNumShards := d.GetChange("num_shards")
+	oldSpecs, newSpecs := d.GetChange("replication_specs")
+	removedShards := countClusterShards(oldNumShards, oldSpecs) - countClusterShards(newNumShards, newSpecs)
+	if removedShards > 0 {
+		log.Printf("[WARN] removing %d shard(s) of cluster %s, Atlas moves their data to the remaining shards before the update completes, which can take hours", removedShards, clusterName)
+		timeout = clusterShardRemovalTimeout(timeout)
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"CREATING", "UPDATING", "REPAIRING"},
-		Target:     []string{"IDLE"},
-		Refresh:    resourceClusterRefreshFunc(clusterName, projectID, conn),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 30 * time.Second,
-		Delay:      1 * time.Minute,
+	refreshFunc := resourceClusterRefreshFunc(clusterName, projectID, conn)
+	if isMultiCloudCluster(d) {
+		refreshFunc = advancedClusterRefreshFunc(clusterName, projectID, conn)
 	}
 
-	// Wait, catching any errors
-	_, err := stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf(errorCreate, err)
-	}
+	stateConf := clusterUpdateStateConf(refreshFunc, timeout)
 
-	return resourceMongoDBAtlasClusterRead(d, meta)
-}
+	//A paused cluster is resumed before applying the other changes, Atlas rejects them otherwise.
+	if d.HasChange("paused") && !d.Get("paused").(bool) {
+		if err := updateClusterPaused(conn, projectID, clusterName, false, isMultiCloudCluster(d)); err != nil {
+			return fmt.Errorf(errorUpdate, clusterName, err)
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf(errorUpdate, clusterName, err)
+		}
+	}
 
-func resourceMongoDBAtlasClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	//Get client connection.
-	conn := meta.(*matlas.Client)
-	ids := decodeStateID(d.Id())
-	projectID := ids["project_id"]
+	//The forced reconfiguration is applied on its own, e.g. to recover from a regional outage
+	//before changing the topology.
+	if v, ok := d.GetOk("accept_data_risks_and_force_replica_set_reconfig"); ok && d.HasChange("accept_data_risks_and_force_replica_set_reconfig") {
+		err := retryOnSnapshotInProgress(retry, timeout, func() error {
+			return forceClusterReplicaSetReconfig(conn, projectID, clusterName, v.(string), isMultiCloudCluster(d))
+		})
+		if err != nil {
+			return fmt.Errorf(errorUpdate, clusterName, err)
+		}
+		if err := waitForClusterReconfig(refreshFunc, timeout, 30*time.Second); err != nil {
+			return fmt.Errorf(errorUpdate, clusterName, err)
+		}
+	}
+
+	if isMultiCloudCluster(d) {
+		//The MongoDB version upgrade can't be combined with other changes.
+		if d.HasChange("mongo_db_major_version") {
+			err := retryOnSnapshotInProgress(retry, timeout, func() error {
+				_, _, err := updateAdvancedCluster(conn, projectID, clusterName, &advancedCluster{MongoDBMajorVersion: d.Get("mongo_db_major_version").(string)})
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf(errorUpdate, clusterName, err)
+			}
+			if _, err := stateConf.WaitForState(); err != nil {
+				return fmt.Errorf(errorUpdate, clusterName, err)
+			}
+		}
+
+		clusterRequest := &matlas.Cluster{
+			EncryptionAtRestProvider: d.Get("encryption_at_rest_provider").(string),
+			MongoDBMajorVersion:      d.Get("mongo_db_major_version").(string),
+			ClusterType:              cast.ToString(d.Get("cluster_type")),
+			BackupEnabled:            pointy.Bool(d.Get("backup_enabled").(bool)),
+			DiskSizeGB:               pointy.Float64(d.Get("disk_size_gb").(float64)),
+		}
+		clusterRequest.BiConnector, _ = expandBiConnector(d)
+		//Atlas rejects a major version for the clusters whose version it manages.
+		if d.Get("version_release_system").(string) == clusterContinuousReleaseSystem {
+			clusterRequest.MongoDBMajorVersion = ""
+		}
+
+		advancedRequest, err := expandAdvancedClusterFromCluster(d, clusterRequest)
+		if err != nil {
+			return fmt.Errorf(errorUpdate, clusterName, err)
+		}
+
+		err = retryOnSnapshotInProgress(retry, timeout, func() error {
+			_, _, err := updateAdvancedCluster(conn, projectID, clusterName, advancedRequest)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf(errorUpdate, clusterName, err)
+		}
+	} else {
+		//The zones are removed on their own, before the remaining zones change, so that a zone
+		//that can't be removed yet fails the update without applying the other changes.
+		if specsRemoval != nil {
+			err := retryOnSnapshotInProgress(retry, timeout, func() error {
+				_, _, err := conn.Clusters.Update(context.Background(), projectID, clusterName, &matlas.Cluster{ReplicationSpecs: specsRemoval})
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf(errorUpdate, clusterName, replicationSpecsRemovalError(removedZones, err))
+			}
+			if _, err := stateConf.WaitForState(); err != nil {
+				return fmt.Errorf(errorUpdate, clusterName, err)
+			}
+			if reflect.DeepEqual(cluster.ReplicationSpecs, specsRemoval) {
+				cluster.ReplicationSpecs = nil
+			}
+		}
+
+		batches := clusterUpdateBatches(cluster)
+		for i, batch := range batches {
+			err := retryOnSnapshotInProgress(retry, timeout, func() error {
+				_, _, err := conn.Clusters.Update(context.Background(), projectID, clusterName, batch)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf(errorUpdate, clusterName, err)
+			}
+			//The next batch is only accepted once the cluster applied the previous one.
+			if i < len(batches)-1 {
+				if _, err := stateConf.WaitForState(); err != nil {
+					return fmt.Errorf(errorUpdate, clusterName, err)
+				}
+			}
+		}
+	}
+
+	if d.HasChange("redact_client_log_data") {
+		err := retryOnSnapshotInProgress(retry, timeout, func() error {
+			return updateClusterRedactClientLogData(conn, projectID, clusterName, d.Get("redact_client_log_data").(bool))
+		})
+		if err != nil {
+			return fmt.Errorf(errorUpdate, clusterName, err)
+		}
+	}
+
+	if v, ok := d.GetOk("version_release_system"); ok && d.HasChange("version_release_system") {
+		err := retryOnSnapshotInProgress(retry, timeout, func() error {
+			return updateClusterVersionReleaseSystem(conn, projectID, clusterName, v.(string))
+		})
+		if err != nil {
+			return fmt.Errorf(errorUpdate, clusterName, err)
+		}
+	}
+
+	if v, ok := d.GetOk("root_cert_type"); ok && d.HasChange("root_cert_type") {
+		err := retryOnSnapshotInProgress(retry, timeout, func() error {
+			return updateClusterRootCertType(conn, projectID, clusterName, v.(string))
+		})
+		if err != nil {
+			return fmt.Errorf(errorUpdate, clusterName, err)
+		}
+	}
+
+	if v, ok := d.GetOk("replica_set_scaling_strategy"); ok && d.HasChange("replica_set_scaling_strategy") {
+		err := retryOnSnapshotInProgress(retry, timeout, func() error {
+			return updateClusterReplicaSetScalingStrategy(conn, projectID, clusterName, v.(string))
+		})
+		if err != nil {
+			return fmt.Errorf(errorUpdate, clusterName, err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		err := retryOnSnapshotInProgress(retry, timeout, func() error {
+			return updateClusterTags(conn, projectID, clusterName, expandResourceTags(d.Get("tags").(*schema.Set)))
+		})
+		if err != nil {
+			return fmt.Errorf(errorUpdate, clusterName, err)
+		}
+	}
+
+	if d.HasChange("labels") {
+		err := retryOnSnapshotInProgress(retry, timeout, func() error {
+			return updateClusterLabels(conn, projectID, clusterName, expandClusterLabels(d.Get("labels").(*schema.Set)))
+		})
+		if err != nil {
+			return fmt.Errorf(errorUpdate, clusterName, err)
+		}
+	}
+
+	if d.HasChange("advanced_configuration") {
+		err := retryOnSnapshotInProgress(retry, timeout, func() error {
+			_, err := updateClusterProcessArgs(conn, projectID, clusterName, expandProcessArgs(d))
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf(errorUpdate, clusterName, err)
+		}
+	}
+
+	// Wait, catching any errors
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		if _, ok := err.(*resource.TimeoutError); ok && removedShards > 0 {
+			return fmt.Errorf(errorUpdate, clusterName, fmt.Errorf("the cluster is still moving the data of the removed shards, "+
+				"which continues in Atlas, increase `timeouts.update` for larger clusters: %s", err))
+		}
+		return fmt.Errorf(errorUpdate, clusterName, err)
+	}
+
+	if d.Get("wait_for_mongo_uri_update").(bool) && clusterTopologyChanged(d) {
+		previous, _ := d.GetChange("mongo_uri_updated")
+		refresh := mongoURIUpdatedRefreshFunc(previous.(string), func() (string, error) {
+			c, _, err := conn.Clusters.Get(context.Background(), projectID, clusterName)
+			if err != nil {
+				return "", err
+			}
+			return c.MongoURIUpdated, nil
+		})
+		if err := waitForMongoURIUpdate(refresh, timeout, 30*time.Second); err != nil {
+			return fmt.Errorf(errorUpdate, clusterName, err)
+		}
+	}
+
+	//The FCV is pinned once the cluster runs the upgraded version.
+	if d.HasChange("pinned_fcv") {
+		err := retryOnSnapshotInProgress(retry, timeout, func() error {
+			if v, ok := d.GetOk("pinned_fcv.0.expiration_date"); ok {
+				return pinClusterFCV(conn, projectID, clusterName, v.(string))
+			}
+			return unpinClusterFCV(conn, projectID, clusterName)
+		})
+		if err != nil {
+			return fmt.Errorf(errorUpdate, clusterName, err)
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf(errorUpdate, clusterName, err)
+		}
+	}
+
+	//The cluster is paused once all the other changes are applied.
+	if d.HasChange("paused") && d.Get("paused").(bool) {
+		err := retryOnSnapshotInProgress(retry, timeout, func() error {
+			return updateClusterPaused(conn, projectID, clusterName, true, isMultiCloudCluster(d))
+		})
+		if err != nil {
+			return fmt.Errorf(errorUpdate, clusterName, err)
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf(errorUpdate, clusterName, err)
+		}
+	}
+
+	return resourceMongoDBAtlasClusterRead(d, meta)
+}
+
+// hasClusterTopologyChange returns whether the update changes the replication specs of the
+// cluster, directly or through the arguments they are generated from.
+func hasClusterTopologyChange(d *schema.ResourceData) bool {
+	return d.HasChange("replication_specs") || d.HasChange("regions") ||
+		d.HasChange("replication_factor") || d.HasChange("num_shards")
+}
+
+// resetClusterTopology sets the replication specs, and the arguments they are generated from, to
+// the topology Atlas reports, including the IDs it assigned to the zones and the actual node
+// counts. regions can't be read back, it keeps its prior value so that it's applied again.
+func resetClusterTopology(d *schema.ResourceData, conn *matlas.Client, projectID, clusterName string) error {
+	if d.HasChange("regions") {
+		old, _ := d.GetChange("regions")
+		if err := d.Set("regions", old); err != nil {
+			return err
+		}
+	}
+
+	if isMultiCloudCluster(d) {
+		cluster, _, err := getAdvancedCluster(conn, projectID, clusterName)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("replication_specs", flattenAdvancedReplicationSpecs(cluster.ReplicationSpecs, d.Get("replication_specs").([]interface{}))); err != nil {
+			return err
+		}
+		return setClusterNodeCounts(d, countAdvancedReplicationSpecsNodes(cluster.ReplicationSpecs))
+	}
+
+	cluster, _, err := conn.Clusters.Get(context.Background(), projectID, clusterName)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("replication_specs", flattenReplicationSpecs(cluster.ReplicationSpecs)); err != nil {
+		return err
+	}
+	if err := d.Set("num_shards", cluster.NumShards); err != nil {
+		return err
+	}
+	if err := d.Set("replication_factor", cluster.ReplicationFactor); err != nil {
+		return err
+	}
+	return setClusterNodeCounts(d, countReplicationSpecsNodes(cluster.ReplicationSpecs))
+}
+
+// clusterUpdateBatches splits the changes of a cluster into the updates Atlas accepts, in the order
+// they must be applied: the MongoDB version upgrade can't be combined with any other change, and
+// the cluster type change, e.g. to a sharded cluster, needs the instance size to support it first.
+// Changes that can be combined are sent in a single update.
+func clusterUpdateBatches(cluster *matlas.Cluster) []*matlas.Cluster {
+	var batches []*matlas.Cluster
+	rest := *cluster
+
+	if rest.MongoDBMajorVersion != "" {
+		batches = append(batches, &matlas.Cluster{MongoDBMajorVersion: rest.MongoDBMajorVersion})
+		rest.MongoDBMajorVersion = ""
+	}
+
+	var clusterType *matlas.Cluster
+	if rest.ClusterType != "" {
+		clusterType = &matlas.Cluster{
+			ClusterType:      rest.ClusterType,
+			NumShards:        rest.NumShards,
+			ReplicationSpecs: rest.ReplicationSpecs,
+		}
+		rest.ClusterType = ""
+		rest.NumShards = nil
+		rest.ReplicationSpecs = nil
+	}
+
+	if !reflect.DeepEqual(rest, matlas.Cluster{}) {
+		batches = append(batches, &rest)
+	}
+	if clusterType != nil {
+		batches = append(batches, clusterType)
+	}
+	return batches
+}
+
+// replicationSpecsRemoval returns the prior replication specs without the ones the update removes,
+// along with the zones of the removed specs, or nil if no spec is removed. The specs are matched by
+// ID, or by zone when the prior spec has no ID.
+func replicationSpecsRemoval(priorSpecs []interface{}, specs []matlas.ReplicationSpec) ([]matlas.ReplicationSpec, []string, error) {
+	ids := make(map[string]bool, len(specs))
+	zones := make(map[string]bool, len(specs))
+	for _, spec := range specs {
+		ids[spec.ID] = true
+		zones[spec.ZoneName] = true
+	}
+
+	var kept []matlas.ReplicationSpec
+	var removedZones []string
+	for _, s := range priorSpecs {
+		prior, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		id := cast.ToString(prior["id"])
+		zoneName := cast.ToString(prior["zone_name"])
+		if (id != "" && !ids[id]) || (id == "" && !zones[zoneName]) {
+			removedZones = append(removedZones, zoneName)
+			continue
+		}
+
+		regionsConfig, err := expandRegionsConfig(specRegionsConfig(prior))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		kept = append(kept, matlas.ReplicationSpec{
+			ID:            id,
+			NumShards:     pointy.Int64(cast.ToInt64(prior["num_shards"])),
+			ZoneName:      zoneName,
+			RegionsConfig: regionsConfig,
+		})
+	}
+
+	//Removing all the specs isn't a removal, the cluster is rebuilt from the new ones.
+	if len(removedZones) == 0 || len(kept) == 0 {
+		return nil, nil, nil
+	}
+	return kept, removedZones, nil
+}
+
+// replicationSpecsRemovalError explains the usual cause of a failed zone removal, Atlas only
+// removes the zones of a global cluster once their data was moved to the remaining ones.
+func replicationSpecsRemovalError(zones []string, err error) error {
+	return fmt.Errorf("error removing the replication specs of zones %s, the data of a zone must be moved to the remaining "+
+		"zones before it can be removed, e.g. by mapping its locations to another zone and waiting for the balancer "+
+		"to drain it: %s", strings.Join(zones, ", "), err)
+}
+
+// clusterShardRemovalMinTimeout is the least time the update of a cluster waits when shards are
+// removed, for Atlas to move their data to the remaining shards.
+const clusterShardRemovalMinTimeout = 6 * time.Hour
+
+// clusterShardRemovalTimeout returns the update timeout when shards are removed, extended to
+// clusterShardRemovalMinTimeout if it's shorter.
+func clusterShardRemovalTimeout(timeout time.Duration) time.Duration {
+	if timeout < clusterShardRemovalMinTimeout {
+		return clusterShardRemovalMinTimeout
+	}
+	return timeout
+}
+
+// countClusterShards returns the number of shards of the cluster, the sum of the shards of its
+// zones when it has replication specs.
+func countClusterShards(numShards, replicationSpecs interface{}) int64 {
+	specs, _ := replicationSpecs.([]interface{})
+	if len(specs) == 0 {
+		return cast.ToInt64(numShards)
+	}
+
+	var shards int64
+	for _, s := range specs {
+		if spec, ok := s.(map[string]interface{}); ok {
+			shards += cast.ToInt64(spec["num_shards"])
+		}
+	}
+	return shards
+}
+
+// clusterReconfigStartTimeout is how long the cluster may still report IDLE once Atlas accepted a
+// forced reconfiguration, before it's considered applied.
+const clusterReconfigStartTimeout = 3 * time.Minute
+
+// forceClusterReplicaSetReconfig accepts the risk of losing the writes not replicated yet, so
+// that Atlas reconfigures the replica set even without a majority of electable nodes.
+func forceClusterReplicaSetReconfig(conn *matlas.Client, projectID, clusterName, acceptedAt string, multiCloud bool) error {
+	if multiCloud {
+		_, _, err := updateAdvancedCluster(conn, projectID, clusterName, &advancedCluster{AcceptDataRisksAndForceReplicaSetReconfig: acceptedAt})
+		return err
+	}
+	path := fmt.Sprintf(clustersPath+"/%s", projectID, url.PathEscape(clusterName))
+	req, err := conn.NewRequest(context.Background(), http.MethodPatch, path, map[string]string{"acceptDataRisksAndForceReplicaSetReconfig": acceptedAt})
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do(context.Background(), req, nil)
+	return err
+}
+
+// clusterUpdateStateConf waits for the cluster to be IDLE again once Atlas accepted an update. The
+// network errors that the refresh reports as REPEATING keep the wait going.
+func clusterUpdateStateConf(refresh resource.StateRefreshFunc, timeout time.Duration) *resource.StateChangeConf {
+	return &resource.StateChangeConf{
+		Pending:    []string{"CREATING", "UPDATING", "REPAIRING", "REPEATING", "PENDING"},
+		Target:     []string{"IDLE"},
+		Refresh:    refresh,
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+		Delay:      1 * time.Minute,
+	}
+}
+
+// waitForClusterReconfig waits for the cluster to go through a forced reconfiguration. Atlas may
+// still report IDLE right after accepting it, so the cluster must first leave IDLE, otherwise
+// the wait would succeed before the reconfiguration even started.
+func waitForClusterReconfig(refresh resource.StateRefreshFunc, timeout, pollInterval time.Duration) error {
+	startTimeout := clusterReconfigStartTimeout
+	if timeout < startTimeout {
+		startTimeout = timeout
+	}
+
+	started := &resource.StateChangeConf{
+		Pending:      []string{"IDLE", "REPEATING", "PENDING"},
+		Target:       []string{"UPDATING", "REPAIRING"},
+		Refresh:      refresh,
+		Timeout:      startTimeout,
+		PollInterval: pollInterval,
+	}
+	if _, err := started.WaitForState(); err != nil {
+		if _, ok := err.(*resource.TimeoutError); !ok {
+			return err
+		}
+		log.Printf("[DEBUG] cluster stayed IDLE for %s after the forced reconfiguration, assuming it's applied", startTimeout)
+	}
+
+	done := &resource.StateChangeConf{
+		Pending:      []string{"UPDATING", "REPAIRING", "REPEATING", "PENDING"},
+		Target:       []string{"IDLE"},
+		Refresh:      refresh,
+		Timeout:      timeout,
+		PollInterval: pollInterval,
+	}
+	_, err := done.WaitForState()
+	return err
+}
+
+// updateClusterPaused pauses or resumes the cluster. Atlas requires the pause and resume requests
+// not to change anything else.
+func updateClusterPaused(conn *matlas.Client, projectID, clusterName string, paused, multiCloud bool) error {
+	if multiCloud {
+		_, _, err := updateAdvancedCluster(conn, projectID, clusterName, &advancedCluster{Paused: pointy.Bool(paused)})
+		return err
+	}
+	path := fmt.Sprintf(clustersPath+"/%s", projectID, url.PathEscape(clusterName))
+	req, err := conn.NewRequest(context.Background(), http.MethodPatch, path, map[string]bool{"paused": paused})
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do(context.Background(), req, nil)
+	return err
+}
+
+func resourceMongoDBAtlasClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	projectID := ids["project_id"]
 	clusterName := ids["cluster_name"]
 
-	_, err := conn.Clusters.Delete(context.Background(), projectID, clusterName)
+	if err := validateClusterDeletion(meta.(*MongoDBClient).preventClusterDeletion, clusterName); err != nil {
+		return err
+	}
+
+	err := checkClusterDependents(d.Get("dependents_on_delete").(string), clusterName, func() ([]string, error) {
+		return listClusterDependents(conn, projectID, clusterName)
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Clusters.Delete(context.Background(), projectID, clusterName)
 
 	if err != nil {
 		return fmt.Errorf(errorDelete, clusterName, err)
 	}
 
+	//Atlas keeps deleting the cluster in the background, e.g. for ephemeral clusters in CI.
+	if !d.Get("wait_for_delete").(bool) {
+		log.Printf("[INFO] Not waiting for MongoDB Cluster (%s) to be destroyed", clusterName)
+		return nil
+	}
+
 	log.Println("[INFO] Waiting for MongoDB Cluster to be destroyed")
 
+	refreshFunc := resourceClusterRefreshFunc(clusterName, projectID, conn)
+	if isMultiCloudCluster(d) {
+		refreshFunc = advancedClusterRefreshFunc(clusterName, projectID, conn)
+	}
+
 	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"IDLE", "CREATING", "UPDATING", "REPAIRING", "DELETING"},
+		Pending:    []string{"IDLE", "CREATING", "UPDATING", "REPAIRING", "REPEATING", "DELETING", "PENDING"},
 		Target:     []string{"DELETED"},
-		Refresh:    resourceClusterRefreshFunc(clusterName, projectID, conn),
+		Refresh:    refreshFunc,
 		Timeout:    1 * time.Hour,
 		MinTimeout: 30 * time.Second,
 		Delay:      1 * time.Minute, // Wait 30 secs before starting
@@ -534,201 +1421,2509 @@ func resourceMongoDBAtlasClusterDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceMongoDBAtlasClusterImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	conn := meta.(*matlas.Client)
+func resourceMongoDBAtlasClusterCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if err := validateClusterProviderRegion(d, meta); err != nil {
+		return err
+	}
 
-	parts := strings.SplitN(d.Id(), "-", 2)
-	if len(parts) != 2 {
-		return nil, errors.New("import format error: to import a cluster, use the format {project_id}-{name}")
+	if v, ok := d.GetOk("regions"); ok {
+		if err := validateClusterRegions(v.([]interface{}), cast.ToInt(d.Get("replication_factor"))); err != nil {
+			return err
+		}
 	}
 
-	projectID := parts[0]
-	name := parts[1]
+	if v, ok := d.GetOk("redact_client_log_data"); ok && v.(bool) {
+		if err := validateRedactClientLogDataVersion(d.Get("mongo_db_major_version").(string)); err != nil {
+			return err
+		}
+	}
 
-	u, _, err := conn.Clusters.Get(context.Background(), projectID, name)
-	if err != nil {
-		return nil, fmt.Errorf("couldn't import cluster %s in project %s, error: %s", name, projectID, err)
+	if v, ok := d.GetOk("pinned_fcv.0.expiration_date"); ok && d.HasChange("pinned_fcv") {
+		if err := validatePinnedFCVExpirationDate(v.(string), time.Now()); err != nil {
+			return err
+		}
+	}
+
+	if d.NewValueKnown("provider_instance_size_name") && d.NewValueKnown("regions") && d.NewValueKnown("replication_specs") {
+		if err := validateSharedTierTopology(d.Get("provider_instance_size_name").(string), d.Get("regions").([]interface{}), d.Get("replication_specs").([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	if d.NewValueKnown("cluster_type") && d.NewValueKnown("replication_specs") {
+		if err := validateGeoshardedZones(d.Get("cluster_type").(string), d.Get("replication_specs").([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	if d.NewValueKnown("replication_specs") {
+		biConnector := d.Get("bi_connector").(map[string]interface{})
+		if err := validateBiConnectorAnalyticsNodes(biConnector, d.Get("replication_specs").([]interface{})); err != nil {
+			return err
+		}
+		if err := validateRegionsDiskIOPS(d.Get("replication_specs").([]interface{})); err != nil {
+			return err
+		}
+		if err := validateRegionsAnalyticsNodes(d.Get("replication_specs").([]interface{}), d.Get("allow_analytics_only_regions").(bool)); err != nil {
+			return err
+		}
+	}
+
+	if d.Id() == "" && d.Get("paused").(bool) {
+		return errors.New("a cluster can't be created paused, set `paused` once the cluster is created")
+	}
+
+	//Changing the topology of the cluster changes the connection string, the resources that use it
+	//are planned with the new one once the update waited for it.
+	if d.Id() != "" && d.Get("wait_for_mongo_uri_update").(bool) && clusterTopologyChanged(d) {
+		for _, key := range mongoURIAttributes {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	// backing_provider_name is computed, a value left in the state by an upgrade from a shared-tier
+	// cluster isn't from the configuration and is cleared by the next read.
+	if d.NewValueKnown("provider_name") && d.NewValueKnown("backing_provider_name") &&
+		(d.Id() == "" || d.HasChange("provider_name") || d.HasChange("backing_provider_name")) {
+		backingProviderName := d.Get("backing_provider_name").(string)
+		if d.Id() != "" && !d.HasChange("backing_provider_name") && d.Get("provider_name").(string) != "TENANT" {
+			backingProviderName = ""
+		}
+		if err := validateClusterBackingProviderName(d.Get("provider_name").(string), backingProviderName); err != nil {
+			return err
+		}
+	}
+
+	if d.Id() != "" && !d.HasChange("provider_name") {
+		oldSize, newSize := d.GetChange("provider_instance_size_name")
+		changed := func(key string) bool { return d.HasChange(key) && d.NewValueKnown(key) }
+		if err := validateClusterImmutableProviderSettings(d.Get("provider_name").(string), oldSize.(string), newSize.(string), changed); err != nil {
+			return err
+		}
+	}
+
+	// Disk auto-scaling may have grown the disk beyond the configured size.
+	if d.Id() == "" || d.Get("auto_scaling_disk_gb_enabled").(bool) {
+		return nil
+	}
+	return validateDiskSizeGBChange(d.GetChange("disk_size_gb"))
+}
+
+// sharedTierInstanceSizes are the instance sizes of the shared-tier clusters, which run in a
+// single region.
+var sharedTierInstanceSizes = map[string]bool{"M0": true, "M2": true, "M5": true}
+
+// validateSharedTierTopology returns an error if a shared-tier cluster is configured in several
+// regions or zones, which Atlas only rejects once the apply started.
+func validateSharedTierTopology(instanceSize string, regions, replicationSpecs []interface{}) error {
+	if !sharedTierInstanceSizes[instanceSize] {
+		return nil
+	}
+
+	if len(regions) > 1 {
+		return fmt.Errorf("%s clusters are shared-tier clusters that can't be multi-region, but `regions` has %d regions: set a single region in provider_region_name or use a dedicated instance size, e.g. M10", instanceSize, len(regions))
+	}
+	if len(replicationSpecs) > 1 {
+		return fmt.Errorf("%s clusters are shared-tier clusters that can't be multi-region, but `replication_specs` has %d zones: remove replication_specs or use a dedicated instance size, e.g. M10", instanceSize, len(replicationSpecs))
+	}
+	for _, s := range replicationSpecs {
+		spec, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if regionsConfig, ok := spec["regions_config"].(*schema.Set); ok && regionsConfig.Len() > 1 {
+			return fmt.Errorf("%s clusters are shared-tier clusters that can't be multi-region, but `replication_specs` has %d regions: remove replication_specs or use a dedicated instance size, e.g. M10", instanceSize, regionsConfig.Len())
+		}
+	}
+	return nil
+}
+
+// validateGeoshardedZones returns an error if a GEOSHARDED cluster isn't configured in at least
+// two zones, which Atlas only rejects once the apply started.
+func validateGeoshardedZones(clusterType string, replicationSpecs []interface{}) error {
+	if clusterType != "GEOSHARDED" {
+		return nil
+	}
+
+	zones := make(map[string]bool)
+	for _, s := range replicationSpecs {
+		if spec, ok := s.(map[string]interface{}); ok {
+			zones[cast.ToString(spec["zone_name"])] = true
+		}
+	}
+	if len(zones) < 2 {
+		return fmt.Errorf("GEOSHARDED clusters require at least 2 zones, but `replication_specs` has %d distinct zone_name: add a replication_specs block per zone with a different zone_name, or use cluster_type REPLICASET or SHARDED", len(zones))
+	}
+	return nil
+}
+
+// validateDiskSizeGBChange returns an error if disk_size_gb decreases, Atlas can't shrink
+// the disk of an existing cluster so the change would never be applied.
+func validateDiskSizeGBChange(old, new interface{}) error {
+	oldSize := cast.ToFloat64(old)
+	newSize := cast.ToFloat64(new)
+
+	if oldSize > 0 && newSize > 0 && newSize < oldSize {
+		return fmt.Errorf("disk_size_gb can't be reduced from %v to %v: Atlas doesn't support shrinking the disk of an existing cluster", oldSize, newSize)
+	}
+	return nil
+}
+
+// clusterBackingProviderNames are the cloud providers on which shared-tier clusters run.
+var clusterBackingProviderNames = []string{"AWS", "GCP", "AZURE"}
+
+// validateClusterBackingProviderName returns an error unless backing_provider_name is one of
+// clusterBackingProviderNames for a TENANT cluster, and empty for a dedicated cluster.
+func validateClusterBackingProviderName(providerName, backingProviderName string) error {
+	if providerName != "TENANT" {
+		if backingProviderName != "" {
+			return fmt.Errorf("backing_provider_name (%s) can only be set when provider_name is TENANT, a %s cluster runs on %s: remove backing_provider_name", backingProviderName, providerName, providerName)
+		}
+		return nil
+	}
+
+	for _, name := range clusterBackingProviderNames {
+		if backingProviderName == name {
+			return nil
+		}
+	}
+	if backingProviderName == "" {
+		return fmt.Errorf("backing_provider_name must be set when provider_name is TENANT, to one of %s", strings.Join(clusterBackingProviderNames, ", "))
+	}
+	return fmt.Errorf("backing_provider_name (%s) must be one of %s when provider_name is TENANT", backingProviderName, strings.Join(clusterBackingProviderNames, ", "))
+}
+
+// clusterImmutableProviderSettings returns the provider settings that Atlas can't change on an
+// existing cluster of the given provider and instance size, and the reason why.
+func clusterImmutableProviderSettings(providerName, instanceSizeName string) ([]string, string) {
+	switch {
+	case providerName == "TENANT":
+		return []string{"backing_provider_name", "provider_region_name"}, "shared-tier clusters stay on the cloud provider and region they were created in"
+	case providerName == "AWS" && strings.HasSuffix(instanceSizeName, "_NVME"):
+		return []string{"provider_encrypt_ebs_volume", "provider_volume_type"}, "NVMe storage is always encrypted and provisioned"
+	}
+	return nil, ""
+}
+
+// validateClusterImmutableProviderSettings returns an error if a provider setting that Atlas can't
+// change on the existing cluster changes, instead of failing mid-apply. Settings are only immutable
+// if they are for both the prior and the new instance size, a tier change may change them.
+func validateClusterImmutableProviderSettings(providerName, oldSize, newSize string, changed func(string) bool) error {
+	oldKeys, _ := clusterImmutableProviderSettings(providerName, oldSize)
+	newKeys, reason := clusterImmutableProviderSettings(providerName, newSize)
+
+	immutable := make(map[string]bool, len(oldKeys))
+	for _, key := range oldKeys {
+		immutable[key] = true
+	}
+	for _, key := range newKeys {
+		if !immutable[key] || !changed(key) {
+			continue
+		}
+		return fmt.Errorf("`%s` can't be changed on an existing %s cluster, %s: to change it, the cluster must be replaced, e.g. with `terraform taint`", key, providerName, reason)
+	}
+	return nil
+}
+
+func resourceMongoDBAtlasClusterImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*MongoDBClient).Atlas
+
+	parts := strings.SplitN(d.Id(), "-", 2)
+	if len(parts) != 2 {
+		return nil, errors.New("import format error: to import a cluster, use the format {project_id}-{name}")
+	}
+
+	projectID := parts[0]
+	name := parts[1]
+
+	u, _, err := getClusterWithExtraFields(conn, projectID, name)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't import cluster %s in project %s, error: %s", name, projectID, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"cluster_id":   u.ID,
+		"project_id":   projectID,
+		"cluster_name": u.Name,
+	}))
+
+	if err := d.Set("project_id", u.GroupID); err != nil {
+		log.Printf("[WARN] Error setting project_id for (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("name", u.Name); err != nil {
+		log.Printf("[WARN] Error setting name for (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("wait_for_delete", true); err != nil {
+		log.Printf("[WARN] Error setting wait_for_delete for (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("dependents_on_delete", clusterDependentsWarn); err != nil {
+		log.Printf("[WARN] Error setting dependents_on_delete for (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("wait_for_mongo_uri_update", false); err != nil {
+		log.Printf("[WARN] Error setting wait_for_mongo_uri_update for (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("allow_analytics_only_regions", false); err != nil {
+		log.Printf("[WARN] Error setting allow_analytics_only_regions for (%s): %s", d.Id(), err)
+	}
+
+	//The root certificate and the labels aren't returned by the client, without them a cluster using
+	//the legacy certificate or carrying labels, e.g. set by other tools, would plan a change after the import.
+	if err := d.Set("root_cert_type", u.RootCertType); err != nil {
+		log.Printf("[WARN] Error setting root_cert_type for (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("labels", flattenClusterLabelsSet(u.Labels)); err != nil {
+		log.Printf("[WARN] Error setting labels for (%s): %s", d.Id(), err)
+	}
+
+	//The advanced configuration isn't part of the cluster, without it the first plan isn't clean.
+	if err := setClusterAdvancedConfiguration(d, conn, projectID, u.Name); err != nil {
+		return nil, fmt.Errorf("couldn't import the advanced configuration of cluster %s in project %s, error: %s", name, projectID, err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandBiConnector(d *schema.ResourceData) (matlas.BiConnector, error) {
+	var biConnector matlas.BiConnector
+
+	if v, ok := d.GetOk("bi_connector"); ok {
+		biConnMap := v.(map[string]interface{})
+
+		enabled := cast.ToBool(biConnMap["enabled"])
+
+		biConnector = matlas.BiConnector{
+			Enabled:        &enabled,
+			ReadPreference: cast.ToString(biConnMap["read_preference"]),
+		}
+	}
+	return biConnector, nil
+}
+
+// biConnectorReadPreferences are the read preferences supported by the BI Connector.
+var biConnectorReadPreferences = []string{"primary", "secondary", "analytics"}
+
+func validateBiConnector(v interface{}, k string) (ws []string, errs []error) {
+	biConnector, ok := v.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	readPreference, ok := biConnector["read_preference"].(string)
+	if !ok || readPreference == "" {
+		return
+	}
+
+	for _, p := range biConnectorReadPreferences {
+		if readPreference == p {
+			return
+		}
+	}
+	errs = append(errs, fmt.Errorf("%s.read_preference must be one of %s, got %q", k, strings.Join(biConnectorReadPreferences, ", "), readPreference))
+	return
+}
+
+// validateBiConnectorAnalyticsNodes returns an error if the BI Connector reads from the analytics
+// nodes while no region of the cluster has any, it would never be able to serve a query.
+func validateBiConnectorAnalyticsNodes(biConnector map[string]interface{}, replicationSpecs []interface{}) error {
+	if !cast.ToBool(biConnector["enabled"]) || cast.ToString(biConnector["read_preference"]) != "analytics" {
+		return nil
+	}
+
+	for _, s := range replicationSpecs {
+		spec, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, r := range specRegionsConfig(spec) {
+			if region, ok := r.(map[string]interface{}); ok && cast.ToInt(region["analytics_nodes"]) > 0 {
+				return nil
+			}
+		}
+	}
+	return errors.New("bi_connector.read_preference is `analytics` but the cluster has no analytics nodes, set `analytics_nodes` in at least one region of `replication_specs` or use another read preference")
+}
+
+// validateRegionsDiskIOPS returns an error if a region sets disk_iops on a cluster that isn't
+// multi-cloud: the IOPS per region are only supported by the advanced clusters API, which is only
+// used when the regions set their own provider.
+func validateRegionsDiskIOPS(replicationSpecs []interface{}) error {
+	var diskIOPSRegion string
+	for _, s := range replicationSpecs {
+		spec, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, r := range specRegionsConfig(spec) {
+			region, ok := r.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if cast.ToString(region["provider_name"]) != "" {
+				return nil
+			}
+			if cast.ToInt(region["disk_iops"]) > 0 && diskIOPSRegion == "" {
+				diskIOPSRegion = cast.ToString(region["region_name"])
+			}
+		}
+	}
+	if diskIOPSRegion != "" {
+		return fmt.Errorf("disk_iops of region %s can only be set when the regions of `replication_specs` set their provider_name, otherwise use provider_disk_iops", diskIOPSRegion)
+	}
+	return nil
+}
+
+// specRegionsConfig returns the regions of a replication spec, a set when the spec is read from the
+// schema and a list otherwise.
+func specRegionsConfig(spec map[string]interface{}) []interface{} {
+	switch v := spec["regions_config"].(type) {
+	case *schema.Set:
+		return v.List()
+	case []interface{}:
+		return v
+	}
+	return nil
+}
+
+// validateRegionsAnalyticsNodes returns an error if a region of replication_specs has analytics
+// nodes but neither electable nor read-only nodes, unless such regions are explicitly allowed.
+func validateRegionsAnalyticsNodes(replicationSpecs []interface{}, allowAnalyticsOnly bool) error {
+	if allowAnalyticsOnly {
+		return nil
+	}
+
+	for _, s := range replicationSpecs {
+		spec, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, r := range specRegionsConfig(spec) {
+			region, ok := r.(map[string]interface{})
+			if !ok || cast.ToInt(region["analytics_nodes"]) == 0 {
+				continue
+			}
+			if cast.ToInt(region["electable_nodes"])+cast.ToInt(region["read_only_nodes"]) == 0 {
+				return fmt.Errorf("region %s of `replication_specs` only has analytics nodes, analytics nodes must be placed in a region "+
+					"with electable or read-only nodes: add them to the region or set allow_analytics_only_regions", cast.ToString(region["region_name"]))
+			}
+		}
+	}
+	return nil
+}
+
+// biConnectorDiffSuppressFunc ignores the read preference while the BI Connector is disabled or
+// when it isn't configured, since Atlas returns a default one regardless of the configuration.
+func biConnectorDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	o, n := d.GetChange("bi_connector")
+	oldBiConnector := o.(map[string]interface{})
+	newBiConnector := n.(map[string]interface{})
+
+	enabled, ok := newBiConnector["enabled"]
+	if !ok {
+		enabled = oldBiConnector["enabled"]
+	}
+	if cast.ToBool(enabled) && cast.ToString(newBiConnector["read_preference"]) != "" {
+		return false
+	}
+
+	switch k {
+	case "bi_connector.read_preference":
+		return true
+	case "bi_connector.%":
+		// The number of keys only differs because of the read preference.
+		return biConnectorLenWithoutReadPreference(oldBiConnector) == biConnectorLenWithoutReadPreference(newBiConnector)
+	}
+	return false
+}
+
+func biConnectorLenWithoutReadPreference(biConnector map[string]interface{}) int {
+	if _, ok := biConnector["read_preference"]; ok {
+		return len(biConnector) - 1
+	}
+	return len(biConnector)
+}
+
+func flattenBiConnector(biConnector matlas.BiConnector) map[string]interface{} {
+	biConnectorMap := make(map[string]interface{})
+
+	if biConnector.Enabled != nil {
+		biConnectorMap["enabled"] = strconv.FormatBool(*biConnector.Enabled)
+	}
+
+	if biConnector.ReadPreference != "" {
+		biConnectorMap["read_preference"] = biConnector.ReadPreference
+	}
+
+	return biConnectorMap
+}
+
+func expandProviderSetting(d *schema.ResourceData) matlas.ProviderSettings {
+	encryptEBSVolume := cast.ToBool(d.Get("provider_encrypt_ebs_volume"))
+	regionName, _ := atlasClusterRegionName(clusterRegionProviderName(d), cast.ToString(d.Get("provider_region_name")))
+	region, _ := valRegion(regionName)
+
+	providerSettings := matlas.ProviderSettings{
+		EncryptEBSVolume:    &encryptEBSVolume,
+		BackingProviderName: cast.ToString(d.Get("backing_provider_name")),
+		DiskTypeName:        cast.ToString(d.Get("provider_disk_type_name")),
+		InstanceSizeName:    cast.ToString(d.Get("provider_instance_size_name")),
+		ProviderName:        cast.ToString(d.Get("provider_name")),
+		RegionName:          region,
+		VolumeType:          cast.ToString(d.Get("provider_volume_type")),
+	}
+
+	//The IOPS are only sent when they're set explicitly, otherwise the IOPS that Atlas assigned are
+	//kept in the state and sending them back would pin them, e.g. when the disk size of a gp3 volume
+	//changes and Atlas would assign new ones.
+	if diskIOPS := cast.ToInt64(d.Get("provider_disk_iops")); diskIOPS != 0 && d.HasChange("provider_disk_iops") {
+		providerSettings.DiskIOPS = &diskIOPS
+	}
+
+	return providerSettings
+}
+
+// diskSizeGBDiffSuppressFunc suppresses the diff of a disk grown by auto-scaling beyond the
+// configured size, which is then the initial size of the disk. Growing it further isn't suppressed.
+func diskSizeGBDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	if !cast.ToBool(d.Get("auto_scaling_disk_gb_enabled")) || old == "" || new == "" {
+		return false
+	}
+	return cast.ToFloat64(old) >= cast.ToFloat64(new)
+}
+
+// providerDiskIOPSDiffSuppressFunc suppresses the diff of provider_disk_iops when it's set to 0,
+// which, like leaving it unset, leaves the IOPS to Atlas: the IOPS it assigns, e.g. from the disk
+// size of gp3 volumes, are read into the state but don't make a diff.
+func providerDiskIOPSDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return new == "0"
+}
+
+func flattenProviderSettings(d *schema.ResourceData, settings matlas.ProviderSettings) {
+	if err := d.Set("backing_provider_name", settings.BackingProviderName); err != nil {
+		log.Printf("[WARN] error setting cluster `backing_provider_name`: %s", err)
+	}
+
+	if settings.DiskIOPS != nil && *settings.DiskIOPS != 0 {
+		if err := d.Set("provider_disk_iops", *settings.DiskIOPS); err != nil {
+			log.Printf("[WARN] error setting cluster `disk_iops`: %s", err)
+		}
+	}
+
+	if err := d.Set("provider_disk_type_name", settings.DiskTypeName); err != nil {
+		log.Printf("[WARN] error setting cluster `disk_type_name`: %s", err)
+	}
+
+	if err := d.Set("provider_encrypt_ebs_volume", settings.EncryptEBSVolume); err != nil {
+		log.Printf("[WARN] error setting cluster `encrypt_ebs_volume`: %s", err)
+	}
+
+	if err := d.Set("provider_instance_size_name", settings.InstanceSizeName); err != nil {
+		log.Printf("[WARN] error setting cluster `instance_size_name`: %s", err)
+	}
+
+	if err := d.Set("provider_name", settings.ProviderName); err != nil {
+		log.Printf("[WARN] error setting cluster `provider_name`: %s", err)
+	}
+
+	if err := d.Set("provider_region_name", settings.RegionName); err != nil {
+		log.Printf("[WARN] error setting cluster `region_name`: %s", err)
+	}
+
+	if err := d.Set("provider_volume_type", settings.VolumeType); err != nil {
+		log.Printf("[WARN] error setting cluster `volume_type`: %s", err)
+	}
+}
+
+func expandReplicationSpecs(d *schema.ResourceData) ([]matlas.ReplicationSpec, error) {
+	rSpecs := make([]matlas.ReplicationSpec, 0)
+
+	if v, ok := d.GetOk("regions"); ok {
+		old, _ := d.GetChange("replication_specs")
+		priorSpecs, _ := old.([]interface{})
+		regions := make([]interface{}, 0, len(v.([]interface{})))
+		for _, region := range v.([]interface{}) {
+			regionName, _ := atlasClusterRegionName(clusterRegionProviderName(d), cast.ToString(region))
+			regions = append(regions, regionName)
+		}
+		return expandRegionsReplicationSpecs(
+			regions,
+			cast.ToInt(d.Get("replication_factor")),
+			cast.ToInt64(d.Get("num_shards")),
+			matchReplicationSpecID(priorSpecs, 0, defaultZoneName),
+		)
+	}
+
+	if v, ok := d.GetOk("replication_specs"); ok {
+		for i, s := range v.([]interface{}) {
+			spec := s.(map[string]interface{})
+
+			regionsConfig, err := expandRegionsConfig(spec["regions_config"].(*schema.Set).List())
+			if err != nil {
+				return rSpecs, err
+			}
+
+			rSpec := matlas.ReplicationSpec{
+				ID:            replicationSpecID(d, i, spec),
+				NumShards:     pointy.Int64(cast.ToInt64(spec["num_shards"])),
+				ZoneName:      cast.ToString(spec["zone_name"]),
+				RegionsConfig: regionsConfig,
+			}
+			rSpecs = append(rSpecs, rSpec)
+		}
+	}
+
+	return rSpecs, nil
+}
+
+// replicationSpecID returns the ID of the replication spec, carrying forward the ID from the
+// prior state when the configuration omits it. Without the ID Atlas rebuilds the zone instead
+// of updating its regions in place.
+func replicationSpecID(d *schema.ResourceData, index int, spec map[string]interface{}) string {
+	if id := cast.ToString(spec["id"]); id != "" {
+		return id
+	}
+	old, _ := d.GetChange("replication_specs")
+	priorSpecs, _ := old.([]interface{})
+	return matchReplicationSpecID(priorSpecs, index, cast.ToString(spec["zone_name"]))
+}
+
+// matchReplicationSpecID looks for the prior spec of the same zone, falling back to the spec at
+// the same position if its zone didn't change.
+func matchReplicationSpecID(priorSpecs []interface{}, index int, zoneName string) string {
+	if zoneName != "" {
+		for _, s := range priorSpecs {
+			if prior, ok := s.(map[string]interface{}); ok && cast.ToString(prior["zone_name"]) == zoneName {
+				return cast.ToString(prior["id"])
+			}
+		}
+	}
+	if index < len(priorSpecs) {
+		if prior, ok := priorSpecs[index].(map[string]interface{}); ok && cast.ToString(prior["zone_name"]) == zoneName {
+			return cast.ToString(prior["id"])
+		}
+	}
+	return ""
+}
+
+// expandRegionsReplicationSpecs translates the ordered regions, primary first, into a single
+// replication spec whose regions have descending priorities.
+func expandRegionsReplicationSpecs(regions []interface{}, totalNodes int, numShards int64, specID string) ([]matlas.ReplicationSpec, error) {
+	if err := validateClusterRegions(regions, totalNodes); err != nil {
+		return nil, err
+	}
+
+	electableNodes := distributeElectableNodes(clusterRegionsNodes(totalNodes), len(regions))
+	regionsConfig := make([]interface{}, 0, len(regions))
+	for i, region := range regions {
+		regionsConfig = append(regionsConfig, map[string]interface{}{
+			"region_name":     region,
+			"electable_nodes": electableNodes[i],
+			"priority":        7 - i,
+			"read_only_nodes": 0,
+			"analytics_nodes": 0,
+		})
+	}
+
+	rConfig, err := expandRegionsConfig(regionsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return []matlas.ReplicationSpec{{
+		ID:            specID,
+		NumShards:     pointy.Int64(numShards),
+		ZoneName:      defaultZoneName,
+		RegionsConfig: rConfig,
+	}}, nil
+}
+
+// validateClusterRegions checks that the regions are unique and that the total number of
+// electable nodes, taken from replication_factor, can place at least one node in each of them.
+func validateClusterRegions(regions []interface{}, totalNodes int) error {
+	seen := make(map[string]bool)
+	for _, r := range regions {
+		region := cast.ToString(r)
+		if seen[region] {
+			return fmt.Errorf("`regions` contains the region %s more than once", region)
+		}
+		seen[region] = true
+	}
+
+	totalNodes = clusterRegionsNodes(totalNodes)
+	if totalNodes != 3 && totalNodes != 5 && totalNodes != 7 {
+		return fmt.Errorf("`replication_factor` must be 3, 5 or 7 when `regions` is set, got %d", totalNodes)
+	}
+	if totalNodes < len(regions) {
+		return fmt.Errorf("`replication_factor` (%d) must be at least the number of `regions` (%d)", totalNodes, len(regions))
+	}
+	return nil
+}
+
+// clusterRegionsNodes returns the total number of electable nodes, replication_factor
+// defaults to 3 when it isn't known yet.
+func clusterRegionsNodes(replicationFactor int) int {
+	if replicationFactor == 0 {
+		return 3
+	}
+	return replicationFactor
+}
+
+// distributeElectableNodes spreads the nodes as evenly as possible across the regions, the
+// remaining nodes go to the regions with higher priority, e.g. 5 nodes in 3 regions are 2-2-1.
+func distributeElectableNodes(totalNodes, regions int) []int {
+	nodes := make([]int, regions)
+	for i := range nodes {
+		nodes[i] = totalNodes / regions
+		if i < totalNodes%regions {
+			nodes[i]++
+		}
+	}
+	return nodes
+}
+
+// countReplicationSpecsNodes returns the number of nodes of each type in the whole cluster,
+// i.e. the nodes of the regions of every zone times its number of shards.
+func countReplicationSpecsNodes(rSpecs []matlas.ReplicationSpec) map[string]int64 {
+	counts := map[string]int64{
+		"total_electable_nodes": 0,
+		"total_read_only_nodes": 0,
+		"total_analytics_nodes": 0,
+	}
+	for _, rSpec := range rSpecs {
+		numShards := int64(1)
+		if rSpec.NumShards != nil && *rSpec.NumShards > 0 {
+			numShards = *rSpec.NumShards
+		}
+		for _, regionConfig := range rSpec.RegionsConfig {
+			counts["total_electable_nodes"] += numShards * cast.ToInt64(regionConfig.ElectableNodes)
+			counts["total_read_only_nodes"] += numShards * cast.ToInt64(regionConfig.ReadOnlyNodes)
+			counts["total_analytics_nodes"] += numShards * cast.ToInt64(regionConfig.AnalyticsNodes)
+		}
+	}
+	return counts
+}
+
+func setClusterNodeCounts(d *schema.ResourceData, counts map[string]int64) error {
+	for k, v := range counts {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func flattenReplicationSpecs(rSpecs []matlas.ReplicationSpec) []map[string]interface{} {
+	specs := make([]map[string]interface{}, 0)
+	for _, rSpec := range rSpecs {
+		spec := map[string]interface{}{
+			"id":             rSpec.ID,
+			"num_shards":     rSpec.NumShards,
+			"zone_name":      rSpec.ZoneName,
+			"regions_config": flattenRegionsConfig(rSpec.RegionsConfig),
+		}
+		specs = append(specs, spec)
+	}
+	return specs
+}
+
+func expandRegionsConfig(regions []interface{}) (map[string]matlas.RegionsConfig, error) {
+	regionsConfig := make(map[string]matlas.RegionsConfig)
+	for _, r := range regions {
+		region := r.(map[string]interface{})
+
+		r, err := valRegion(regionsConfigRegionName(region))
+		if err != nil {
+			return regionsConfig, err
+		}
+
+		regionsConfig[r] = matlas.RegionsConfig{
+			AnalyticsNodes: pointy.Int64(cast.ToInt64(region["analytics_nodes"])),
+			ElectableNodes: pointy.Int64(cast.ToInt64(region["electable_nodes"])),
+			Priority:       pointy.Int64(cast.ToInt64(region["priority"])),
+			ReadOnlyNodes:  pointy.Int64(cast.ToInt64(region["read_only_nodes"])),
+		}
+	}
+	return regionsConfig, nil
+}
+
+func flattenRegionsConfig(regionsConfig map[string]matlas.RegionsConfig) []map[string]interface{} {
+	regions := make([]map[string]interface{}, 0)
+
+	for regionName, regionConfig := range regionsConfig {
+		region := map[string]interface{}{
+			"region_name":     regionName,
+			"priority":        regionConfig.Priority,
+			"analytics_nodes": regionConfig.AnalyticsNodes,
+			"electable_nodes": regionConfig.ElectableNodes,
+			"read_only_nodes": regionConfig.ReadOnlyNodes,
+		}
+		regions = append(regions, region)
+	}
+	return regions
+}
+
+// awsRegionNameRegex matches the AWS names of the regions, e.g. us-east-1 or us-gov-west-1.
+var awsRegionNameRegex = regexp.MustCompile(`^[a-z]{2}(-gov)?-[a-z]+-\d$`)
+
+// clusterRegionNames maps the names the cloud providers give to their regions to the names Atlas
+// uses for them, per provider, so configurations migrated from the provider can keep its names.
+var clusterRegionNames = map[string]map[string]string{
+	"AWS": {
+		"us-east-1":      "US_EAST_1",
+		"us-east-2":      "US_EAST_2",
+		"us-west-1":      "US_WEST_1",
+		"us-west-2":      "US_WEST_2",
+		"ca-central-1":   "CA_CENTRAL_1",
+		"sa-east-1":      "SA_EAST_1",
+		"ap-northeast-1": "AP_NORTHEAST_1",
+		"ap-northeast-2": "AP_NORTHEAST_2",
+		"ap-south-1":     "AP_SOUTH_1",
+		"ap-southeast-1": "AP_SOUTHEAST_1",
+		"ap-southeast-2": "AP_SOUTHEAST_2",
+		"eu-central-1":   "EU_CENTRAL_1",
+		"eu-north-1":     "EU_NORTH_1",
+		"eu-west-1":      "EU_WEST_1",
+		"eu-west-2":      "EU_WEST_2",
+		"eu-west-3":      "EU_WEST_3",
+	},
+	"GCP": {
+		"us-central1":             "CENTRAL_US",
+		"us-east1":                "EASTERN_US",
+		"us-east4":                "US_EAST_4",
+		"us-west1":                "WESTERN_US",
+		"us-west2":                "US_WEST_2",
+		"northamerica-northeast1": "NORTH_AMERICA_NORTHEAST_1",
+		"southamerica-east1":      "SOUTH_AMERICA_EAST_1",
+		"asia-east1":              "EASTERN_ASIA_PACIFIC",
+		"asia-east2":              "ASIA_EAST_2",
+		"asia-northeast1":         "NORTHEASTERN_ASIA_PACIFIC",
+		"asia-northeast2":         "ASIA_NORTHEAST_2",
+		"asia-southeast1":         "SOUTHEASTERN_ASIA_PACIFIC",
+		"asia-south1":             "ASIA_SOUTH_1",
+		"australia-southeast1":    "AUSTRALIA_SOUTHEAST_1",
+		"europe-west1":            "WESTERN_EUROPE",
+		"europe-north1":           "EUROPE_NORTH_1",
+		"europe-west2":            "EUROPE_WEST_2",
+		"europe-west3":            "EUROPE_WEST_3",
+		"europe-west4":            "EUROPE_WEST_4",
+		"europe-west6":            "EUROPE_WEST_6",
+	},
+	"AZURE": {
+		"centralus":          "US_CENTRAL",
+		"eastus":             "US_EAST",
+		"eastus2":            "US_EAST_2",
+		"northcentralus":     "US_NORTH_CENTRAL",
+		"westus":             "US_WEST",
+		"westus2":            "US_WEST_2",
+		"southcentralus":     "US_SOUTH_CENTRAL",
+		"brazilsouth":        "BRAZIL_SOUTH",
+		"canadaeast":         "CANADA_EAST",
+		"canadacentral":      "CANADA_CENTRAL",
+		"northeurope":        "EUROPE_NORTH",
+		"westeurope":         "EUROPE_WEST",
+		"uksouth":            "UK_SOUTH",
+		"ukwest":             "UK_WEST",
+		"francecentral":      "FRANCE_CENTRAL",
+		"eastasia":           "ASIA_EAST",
+		"southeastasia":      "ASIA_SOUTH_EAST",
+		"australiaeast":      "AUSTRALIA_EAST",
+		"australiasoutheast": "AUSTRALIA_SOUTH_EAST",
+		"centralindia":       "INDIA_CENTRAL",
+		"southindia":         "INDIA_SOUTH",
+		"westindia":          "INDIA_WEST",
+		"japaneast":          "JAPAN_EAST",
+		"japanwest":          "JAPAN_WEST",
+		"koreacentral":       "KOREA_CENTRAL",
+		"koreasouth":         "KOREA_SOUTH",
+		"southafricanorth":   "SOUTH_AFRICA_NORTH",
+		"uaenorth":           "UAE_NORTH",
+	},
+}
+
+// atlasClusterRegionName returns the Atlas name of a region given by the name of its cloud provider,
+// and whether the name was converted. The names of all the providers are looked up when the provider
+// isn't known, e.g. at validation, they don't overlap.
+func atlasClusterRegionName(providerName, name string) (string, bool) {
+	if names, ok := clusterRegionNames[providerName]; ok {
+		atlasName, ok := names[name]
+		if !ok {
+			return name, false
+		}
+		return atlasName, true
+	}
+	for _, names := range clusterRegionNames {
+		if atlasName, ok := names[name]; ok {
+			return atlasName, true
+		}
+	}
+	return name, false
+}
+
+// validateClusterRegionName rejects the cloud provider names of the regions, e.g. us-east-1 of AWS,
+// us-central1 of GCP or eastus of Azure, since Atlas uses its own names, e.g. US_EAST_1.
+func validateClusterRegionName(v interface{}, k string) (ws []string, es []error) {
+	name := v.(string)
+	if atlasName, ok := atlasClusterRegionName("", name); ok {
+		es = append(es, fmt.Errorf("%s: %q is the cloud provider name of the region, Atlas expects its own region names, use %q instead",
+			k, name, atlasName))
+		return
+	}
+	if awsRegionNameRegex.MatchString(name) {
+		es = append(es, fmt.Errorf("%s: %q is the AWS name of the region, Atlas expects its own region names, use %q instead",
+			k, name, strings.ToUpper(strings.Replace(name, "-", "_", -1))))
+	}
+	return
+}
+
+// validateClusterProviderRegionName accepts the names the cloud providers give to their regions,
+// which are converted to the Atlas names before they're sent, but warns about them.
+func validateClusterProviderRegionName(v interface{}, k string) (ws []string, es []error) {
+	name := v.(string)
+	atlasName, ok := atlasClusterRegionName("", name)
+	if !ok {
+		return validateClusterRegionName(v, k)
+	}
+	log.Printf("[WARN] %s: %q is the cloud provider name of the region, it's converted to %q", k, name, atlasName)
+	ws = append(ws, fmt.Sprintf("%s: %q is the cloud provider name of the region, support for it is deprecated, use the Atlas name %q instead",
+		k, name, atlasName))
+	return
+}
+
+// clusterRegionNameDiffSuppressFunc suppresses the diff between the cloud provider name of a region
+// in the configuration and its Atlas name read from the API.
+func clusterRegionNameDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	atlasName, ok := atlasClusterRegionName(clusterRegionProviderName(d), new)
+	return ok && atlasName == old
+}
+
+// clusterRegionProviderName returns the cloud provider of the regions of the cluster, the backing
+// provider of a shared-tier cluster.
+func clusterRegionProviderName(d *schema.ResourceData) string {
+	providerName := cast.ToString(d.Get("provider_name"))
+	if providerName == "TENANT" {
+		return cast.ToString(d.Get("backing_provider_name"))
+	}
+	return providerName
+}
+
+var mongoURIOptionNameRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]*$`)
+
+func validateMongoURIOptions(v interface{}, k string) (ws []string, es []error) {
+	for name, value := range v.(map[string]interface{}) {
+		if !mongoURIOptionNameRegex.MatchString(name) {
+			es = append(es, fmt.Errorf("%s: %q is not a valid connection string option name", k, name))
+		}
+		if cast.ToString(value) == "" {
+			es = append(es, fmt.Errorf("%s: the value of the connection string option %q can't be empty", k, name))
+		}
+	}
+	return
+}
+
+// composeMongoURI adds the given options to the query of a connection string, overriding
+// the options already present. The connection string is not parsed with net/url because
+// it may contain several comma-separated hosts.
+func composeMongoURI(mongoURI string, options map[string]interface{}) (string, error) {
+	if mongoURI == "" || len(options) == 0 {
+		return mongoURI, nil
+	}
+
+	base, rawQuery := mongoURI, ""
+	if i := strings.Index(mongoURI, "?"); i >= 0 {
+		base, rawQuery = mongoURI[:i], mongoURI[i+1:]
+	}
+
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return "", fmt.Errorf("error parsing the options of the connection string: %s", err)
+	}
+
+	for name, value := range options {
+		query.Set(name, cast.ToString(value))
+	}
+
+	// The options must follow the path separator, even if there is no default database.
+	if !strings.Contains(strings.TrimPrefix(strings.TrimPrefix(base, "mongodb+srv://"), "mongodb://"), "/") {
+		base += "/"
+	}
+
+	return fmt.Sprintf("%s?%s", base, query.Encode()), nil
+}
+
+// mongoURIDefaultPort is the port of the hosts of a connection string that don't set one.
+const mongoURIDefaultPort = "27017"
+
+// mongoURIHosts returns the host:port of each mongod of a standard connection string. A DNS seed
+// list connection string (mongodb+srv://) only names the SRV record, not the hosts, so it returns
+// an empty list.
+func mongoURIHosts(mongoURI string) []string {
+	hosts := make([]string, 0)
+	if !strings.HasPrefix(mongoURI, "mongodb://") {
+		return hosts
+	}
+
+	seedList := strings.TrimPrefix(mongoURI, "mongodb://")
+	if i := strings.IndexAny(seedList, "/?"); i >= 0 {
+		seedList = seedList[:i]
+	}
+	if i := strings.LastIndex(seedList, "@"); i >= 0 {
+		seedList = seedList[i+1:]
+	}
+
+	for _, host := range strings.Split(seedList, ",") {
+		if host == "" {
+			continue
+		}
+		if _, _, err := net.SplitHostPort(host); err != nil {
+			host = net.JoinHostPort(strings.Trim(host, "[]"), mongoURIDefaultPort)
+		}
+		hosts = append(hosts, host)
+	}
+	return hosts
+}
+
+// clusterProcessesTimeout is how long a new cluster waits for its processes to be registered.
+const clusterProcessesTimeout = 5 * time.Minute
+
+// clusterProcessIDs returns the `host:port` of the processes of the project that run on the hosts
+// of the cluster URI, sorted. The ports of the URI are ignored since sharded clusters expose the
+// mongos processes in the URI, so the processes of the shards running on the same hosts match too.
+func clusterProcessIDs(processes []*process, mongoURI string) []string {
+	hosts := make(map[string]bool)
+	for _, host := range mongoURIHosts(mongoURI) {
+		hostname, _, _ := net.SplitHostPort(host)
+		hosts[strings.ToLower(hostname)] = true
+	}
+
+	processIDs := make([]string, 0)
+	for _, p := range processes {
+		if hosts[strings.ToLower(p.UserAlias)] {
+			processIDs = append(processIDs, fmt.Sprintf("%s:%d", p.UserAlias, p.Port))
+		}
+	}
+	sort.Strings(processIDs)
+	return processIDs
+}
+
+// getClusterProcessIDs returns the `host:port` of the processes of the cluster, which is empty
+// until Atlas registers the processes of a new cluster.
+func getClusterProcessIDs(conn *matlas.Client, projectID, mongoURI string) ([]string, error) {
+	processes, resp, err := listProjectProcesses(conn, projectID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+	return clusterProcessIDs(processes, mongoURI), nil
+}
+
+func setClusterProcessIDs(d *schema.ResourceData, conn *matlas.Client, projectID, mongoURI string) error {
+	processIDs, err := getClusterProcessIDs(conn, projectID, mongoURI)
+	if err != nil {
+		return err
+	}
+
+	//Atlas briefly unregisters the processes while it replaces the hosts, the known ones are kept meanwhile.
+	if len(processIDs) == 0 && len(d.Get("process_ids").([]interface{})) > 0 {
+		log.Printf("[WARN] the processes of cluster (%s) aren't registered, keeping the known `process_ids`", d.Get("name").(string))
+		return nil
+	}
+	return d.Set("process_ids", processIDs)
+}
+
+func getClusterMongoURI(conn *matlas.Client, projectID, clusterName string, multiCloud bool) (string, error) {
+	if multiCloud {
+		cluster, _, err := getAdvancedCluster(conn, projectID, clusterName)
+		if err != nil {
+			return "", err
+		}
+		if cluster.ConnectionStrings == nil {
+			return "", nil
+		}
+		return cluster.ConnectionStrings.Standard, nil
+	}
+
+	cluster, _, err := conn.Clusters.Get(context.Background(), projectID, clusterName)
+	if err != nil {
+		return "", err
+	}
+	return cluster.MongoURI, nil
+}
+
+// clusterProcessIDsRefreshFunc returns REGISTERED once the processes of the cluster are found.
+func clusterProcessIDsRefreshFunc(list func() ([]string, error)) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		processIDs, err := list()
+		if err != nil {
+			return nil, "", err
+		}
+		if len(processIDs) == 0 {
+			return processIDs, "PENDING", nil
+		}
+		return processIDs, "REGISTERED", nil
+	}
+}
+
+func waitForClusterProcesses(refresh resource.StateRefreshFunc, timeout, pollInterval time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"REGISTERED"},
+		Refresh:      refresh,
+		Timeout:      timeout,
+		PollInterval: pollInterval,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("the processes of the cluster weren't registered: %s", err)
+	}
+	return nil
+}
+
+func resourceClusterRefreshFunc(name, projectID string, client *matlas.Client) resource.StateRefreshFunc {
+	return clusterRepairingRefreshFunc(name, clusterRepairingThreshold, clusterEmptyStateRefreshFunc(name, clusterEmptyStateMaxRetries, clusterNetworkErrorRefreshFunc(name, clusterNetworkErrorMaxRetries, clusterNetworkErrorBaseDelay, func() (interface{}, string, error) {
+		c, resp, err := client.Clusters.Get(context.Background(), projectID, name)
+
+		if err != nil && strings.Contains(err.Error(), "reset by peer") {
+			return nil, "REPEATING", nil
+		}
+
+		if err != nil && c == nil && resp == nil {
+			log.Printf("Error reading MongoDB cluster: %s: %s", name, err)
+			return nil, "", err
+		} else if err != nil {
+			if resp.StatusCode == 404 {
+				return 42, "DELETED", nil
+			}
+			log.Printf("Error reading MongoDB Cluster %s: %s", name, err)
+			return nil, "", err
+		}
+
+		if c.StateName != "" {
+			log.Printf("[DEBUG] status for MongoDB cluster: %s: %s", name, c.StateName)
+		}
+
+		return c, c.StateName, nil
+	})))
+}
+
+// mongoURIAttributes are the attributes that change with the connection string of the cluster.
+var mongoURIAttributes = []string{"mongo_uri", "mongo_uri_standard", "mongo_uri_updated", "mongo_uri_with_options", "hosts", "srv_address"}
+
+// clusterTopologyAttributes are the attributes whose change rewrites the hosts of the connection
+// string of the cluster, e.g. a sharded cluster scaled to more shards.
+var clusterTopologyAttributes = []string{"cluster_type", "num_shards", "replication_specs", "regions", "provider_region_name"}
+
+// clusterTopologyChanged returns true if one of the clusterTopologyAttributes changes, it's shared
+// by the plan, a *schema.ResourceDiff, and the update, a *schema.ResourceData.
+func clusterTopologyChanged(d interface{ HasChange(string) bool }) bool {
+	for _, key := range clusterTopologyAttributes {
+		if d.HasChange(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeMongoURIUpdated returns the date when the connection string was last updated in
+// RFC3339 format, e.g. 2020-04-16T15:45:52Z, whatever the precision returned by Atlas.
+func normalizeMongoURIUpdated(mongoURIUpdated string) string {
+	if mongoURIUpdated == "" {
+		return ""
+	}
+	date, err := time.Parse(time.RFC3339Nano, mongoURIUpdated)
+	if err != nil {
+		log.Printf("[WARN] Error parsing mongo_uri_updated (%s): %s", mongoURIUpdated, err)
+		return mongoURIUpdated
+	}
+	return date.UTC().Format(time.RFC3339)
+}
+
+// mongoURIUpdatedRefreshFunc returns UPDATED once the connection string was updated after
+// previous, and STALE until then.
+func mongoURIUpdatedRefreshFunc(previous string, get func() (string, error)) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		current, err := get()
+		if err != nil {
+			return nil, "", err
+		}
+		current = normalizeMongoURIUpdated(current)
+		previous := normalizeMongoURIUpdated(previous)
+
+		if current == "" || current == previous {
+			return current, "STALE", nil
+		}
+		currentDate, cErr := time.Parse(time.RFC3339, current)
+		previousDate, pErr := time.Parse(time.RFC3339, previous)
+		if cErr == nil && pErr == nil && !currentDate.After(previousDate) {
+			return current, "STALE", nil
+		}
+		return current, "UPDATED", nil
+	}
+}
+
+// waitForMongoURIUpdate waits until Atlas updated the connection string of the cluster, which
+// happens after the cluster is IDLE again.
+func waitForMongoURIUpdate(refresh resource.StateRefreshFunc, timeout, pollInterval time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"STALE"},
+		Target:       []string{"UPDATED"},
+		Refresh:      refresh,
+		Timeout:      timeout,
+		PollInterval: pollInterval,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("the connection string of the cluster wasn't updated: %s", err)
+	}
+	return nil
+}
+
+// redactClientLogDataMinVersion is the first MongoDB version that supports log redaction in Atlas.
+const redactClientLogDataMinVersion = "4.4"
+
+// defaultClusterRootCertType is the root certificate authority of the clusters that don't set one.
+const defaultClusterRootCertType = "ISRGROOTX1"
+
+// clusterRootCertTypes are the root certificate authorities of the TLS certificates of the
+// clusters: ISRG Root X1, or the legacy IdenTrust DST Root CA X3.
+var clusterRootCertTypes = []string{defaultClusterRootCertType, "DST"}
+
+// clusterExtraFields holds the cluster fields that aren't supported by the client yet.
+type clusterExtraFields struct {
+	CreateDate           string                     `json:"createDate,omitempty"`
+	RedactClientLogData  *bool                      `json:"redactClientLogData,omitempty"`
+	RootCertType         string                     `json:"rootCertType,omitempty"`
+	ConnectionStrings    *advancedConnectionStrings `json:"connectionStrings,omitempty"`
+	Labels               *[]clusterLabel            `json:"labels,omitempty"`
+	VersionReleaseSystem string                     `json:"versionReleaseSystem,omitempty"`
+}
+
+// clusterContinuousReleaseSystem is the release system where Atlas upgrades the cluster to each
+// new MongoDB release, rather than to the patches of the chosen major version only.
+const clusterContinuousReleaseSystem = "CONTINUOUS"
+
+// mongoDBMajorVersionDiffSuppressFunc ignores mongo_db_major_version when Atlas manages the
+// version of the cluster, otherwise each upgrade made by Atlas would plan a change.
+func mongoDBMajorVersionDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return cast.ToString(d.Get("version_release_system")) == clusterContinuousReleaseSystem
+}
+
+func updateClusterVersionReleaseSystem(conn *matlas.Client, projectID, clusterName, releaseSystem string) error {
+	path := fmt.Sprintf("groups/%s/clusters/%s", projectID, url.PathEscape(clusterName))
+
+	req, err := conn.NewRequest(context.Background(), http.MethodPatch, path, &clusterExtraFields{VersionReleaseSystem: releaseSystem})
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Do(context.Background(), req, nil)
+	return err
+}
+
+// clusterInfrastructureToolLabel is the label Atlas attaches to the clusters to record the tool
+// that manages them. It's left out of the labels of the resource and kept when they're updated.
+const clusterInfrastructureToolLabel = "Infrastructure Tool"
+
+func validateClusterLabelKey(v interface{}, k string) (ws []string, es []error) {
+	if v.(string) == clusterInfrastructureToolLabel {
+		es = append(es, fmt.Errorf("%s: the %q label is reserved by Atlas", k, clusterInfrastructureToolLabel))
+	}
+	return
+}
+
+// updateClusterLabels replaces the labels of the cluster with the given ones, keeping the
+// labels reserved by Atlas.
+func updateClusterLabels(conn *matlas.Client, projectID, clusterName string, labels []clusterLabel) error {
+	cluster, _, err := getClusterWithExtraFields(conn, projectID, clusterName)
+	if err != nil {
+		return err
+	}
+	if cluster.Labels != nil {
+		for _, label := range *cluster.Labels {
+			if label.Key == clusterInfrastructureToolLabel {
+				labels = append(labels, label)
+			}
+		}
+	}
+
+	path := fmt.Sprintf("groups/%s/clusters/%s", projectID, url.PathEscape(clusterName))
+
+	req, err := conn.NewRequest(context.Background(), http.MethodPatch, path, &clusterExtraFields{Labels: &labels})
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Do(context.Background(), req, nil)
+	return err
+}
+
+// expandClusterLabels never returns nil, so that removing every label from the configuration
+// sends an empty list to Atlas instead of leaving the labels unchanged.
+func expandClusterLabels(set *schema.Set) []clusterLabel {
+	labels := make([]clusterLabel, 0, set.Len())
+	for _, v := range set.List() {
+		label := v.(map[string]interface{})
+		labels = append(labels, clusterLabel{
+			Key:   cast.ToString(label["key"]),
+			Value: cast.ToString(label["value"]),
+		})
+	}
+	return labels
+}
+
+// flattenClusterLabelsSet flattens the labels into the blocks of the resource, where the labels
+// are a set, unlike the map of the data sources.
+func flattenClusterLabelsSet(labels *[]clusterLabel) []map[string]interface{} {
+	if labels == nil {
+		return nil
+	}
+	results := make([]map[string]interface{}, 0, len(*labels))
+	for _, label := range *labels {
+		if label.Key == clusterInfrastructureToolLabel {
+			continue
+		}
+		results = append(results, map[string]interface{}{
+			"key":   label.Key,
+			"value": label.Value,
+		})
+	}
+	return results
+}
+
+// setClusterConnectionStrings sets the public and private connection strings of the cluster,
+// the private one is only available with VPC peering or private endpoints.
+func setClusterConnectionStrings(d *schema.ResourceData, connectionStrings *advancedConnectionStrings) error {
+	if connectionStrings == nil {
+		return nil
+	}
+	if err := d.Set("mongo_uri_standard", connectionStrings.Standard); err != nil {
+		return err
+	}
+	return d.Set("mongo_uri_private", connectionStrings.Private)
+}
+
+// clusterWithExtraFields is a cluster with the fields that the client doesn't decode.
+type clusterWithExtraFields struct {
+	matlas.Cluster
+	clusterExtraFields
+}
+
+// getClusterWithExtraFields gets the cluster in a single request, with the fields that
+// conn.Clusters.Get would drop.
+func getClusterWithExtraFields(conn *matlas.Client, projectID, clusterName string) (*clusterWithExtraFields, *matlas.Response, error) {
+	path := fmt.Sprintf("groups/%s/clusters/%s", projectID, url.PathEscape(clusterName))
+
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(clusterWithExtraFields)
+	resp, err := conn.Do(context.Background(), req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
+
+func updateClusterRedactClientLogData(conn *matlas.Client, projectID, clusterName string, redact bool) error {
+	path := fmt.Sprintf("groups/%s/clusters/%s", projectID, url.PathEscape(clusterName))
+
+	req, err := conn.NewRequest(context.Background(), http.MethodPatch, path, &clusterExtraFields{RedactClientLogData: pointy.Bool(redact)})
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Do(context.Background(), req, nil)
+	return err
+}
+
+func updateClusterRootCertType(conn *matlas.Client, projectID, clusterName, rootCertType string) error {
+	path := fmt.Sprintf("groups/%s/clusters/%s", projectID, url.PathEscape(clusterName))
+
+	req, err := conn.NewRequest(context.Background(), http.MethodPatch, path, &clusterExtraFields{RootCertType: rootCertType})
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Do(context.Background(), req, nil)
+	return err
+}
+
+const clusterProcessArgsPath = "groups/%s/clusters/%s/processArgs"
+
+// processArgs holds the advanced configuration options of the mongod processes of a cluster.
+// See more: https://docs.atlas.mongodb.com/reference/api/clusters-modify-advanced-configuration-options/
+type processArgs struct {
+	DefaultReadConcern               string `json:"defaultReadConcern,omitempty"`
+	DefaultWriteConcern              string `json:"defaultWriteConcern,omitempty"`
+	FailIndexKeyTooLong              *bool  `json:"failIndexKeyTooLong,omitempty"`
+	JavascriptEnabled                *bool  `json:"javascriptEnabled,omitempty"`
+	MinimumEnabledTLSProtocol        string `json:"minimumEnabledTlsProtocol,omitempty"`
+	NoTableScan                      *bool  `json:"noTableScan,omitempty"`
+	OplogSizeMB                      *int64 `json:"oplogSizeMB,omitempty"`
+	SampleSizeBIConnector            *int64 `json:"sampleSizeBIConnector,omitempty"`
+	SampleRefreshIntervalBIConnector *int64 `json:"sampleRefreshIntervalBIConnector,omitempty"`
+}
+
+// setClusterAdvancedConfiguration sets advanced_configuration from the process arguments of the
+// cluster.
+func setClusterAdvancedConfiguration(d *schema.ResourceData, conn *matlas.Client, projectID, clusterName string) error {
+	args, _, err := getClusterProcessArgs(conn, projectID, clusterName)
+	if err != nil {
+		return err
+	}
+	return d.Set("advanced_configuration", flattenProcessArgs(args))
+}
+
+func getClusterProcessArgs(conn *matlas.Client, projectID, clusterName string) (*processArgs, *matlas.Response, error) {
+	path := fmt.Sprintf(clusterProcessArgsPath, projectID, url.PathEscape(clusterName))
+
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(processArgs)
+	resp, err := conn.Do(context.Background(), req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
+
+func updateClusterProcessArgs(conn *matlas.Client, projectID, clusterName string, args *processArgs) (*matlas.Response, error) {
+	path := fmt.Sprintf(clusterProcessArgsPath, projectID, url.PathEscape(clusterName))
+
+	req, err := conn.NewRequest(context.Background(), http.MethodPatch, path, args)
+	if err != nil {
+		return nil, err
+	}
+	return conn.Do(context.Background(), req, nil)
+}
+
+// expandProcessArgs only sends the options that are configured or already known, the others keep
+// their Atlas defaults.
+func expandProcessArgs(d *schema.ResourceData) *processArgs {
+	args := &processArgs{
+		DefaultReadConcern:        d.Get("advanced_configuration.0.default_read_concern").(string),
+		DefaultWriteConcern:       d.Get("advanced_configuration.0.default_write_concern").(string),
+		MinimumEnabledTLSProtocol: d.Get("advanced_configuration.0.minimum_enabled_tls_protocol").(string),
+	}
+	if v, ok := d.GetOkExists("advanced_configuration.0.fail_index_key_too_long"); ok {
+		args.FailIndexKeyTooLong = pointy.Bool(v.(bool))
+	}
+	if v, ok := d.GetOkExists("advanced_configuration.0.javascript_enabled"); ok {
+		args.JavascriptEnabled = pointy.Bool(v.(bool))
+	}
+	if v, ok := d.GetOkExists("advanced_configuration.0.no_table_scan"); ok {
+		args.NoTableScan = pointy.Bool(v.(bool))
+	}
+	if v, ok := d.GetOk("advanced_configuration.0.oplog_size_mb"); ok {
+		args.OplogSizeMB = pointy.Int64(cast.ToInt64(v))
+	}
+	if v, ok := d.GetOk("advanced_configuration.0.sample_size_bi_connector"); ok {
+		args.SampleSizeBIConnector = pointy.Int64(cast.ToInt64(v))
+	}
+	if v, ok := d.GetOk("advanced_configuration.0.sample_refresh_interval_bi_connector"); ok {
+		args.SampleRefreshIntervalBIConnector = pointy.Int64(cast.ToInt64(v))
+	}
+	return args
+}
+
+func flattenProcessArgs(args *processArgs) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"default_read_concern":                 args.DefaultReadConcern,
+			"default_write_concern":                args.DefaultWriteConcern,
+			"fail_index_key_too_long":              cast.ToBool(args.FailIndexKeyTooLong),
+			"javascript_enabled":                   cast.ToBool(args.JavascriptEnabled),
+			"minimum_enabled_tls_protocol":         args.MinimumEnabledTLSProtocol,
+			"no_table_scan":                        cast.ToBool(args.NoTableScan),
+			"oplog_size_mb":                        cast.ToInt64(args.OplogSizeMB),
+			"sample_size_bi_connector":             cast.ToInt64(args.SampleSizeBIConnector),
+			"sample_refresh_interval_bi_connector": cast.ToInt64(args.SampleRefreshIntervalBIConnector),
+		},
+	}
+}
+
+const clusterV2Path = "../v2/groups/%s/clusters/%s"
+
+// clusterPinnedFCV holds the feature compatibility version of a cluster, only exposed by the
+// versioned API.
+// See more: https://www.mongodb.com/docs/atlas/reference/api-resources-spec/v2/#tag/Clusters/operation/pinFeatureCompatibilityVersion
+type clusterPinnedFCV struct {
+	FeatureCompatibilityVersion               string `json:"featureCompatibilityVersion,omitempty"`
+	FeatureCompatibilityVersionExpirationDate string `json:"featureCompatibilityVersionExpirationDate,omitempty"`
+}
+
+// clusterReplicaSetScalingStrategies are the ways Atlas scales the nodes of the replica sets: all
+// of them one after another, the analytics nodes in parallel with the operational ones, or the
+// electable nodes in parallel with the read-only and analytics ones.
+var clusterReplicaSetScalingStrategies = []string{"SEQUENTIAL", "WORKLOAD_TYPE", "NODE_TYPE"}
+
+// clusterV2Fields holds the cluster fields only exposed by the versioned API.
+type clusterV2Fields struct {
+	clusterPinnedFCV
+	ReplicaSetScalingStrategy string          `json:"replicaSetScalingStrategy,omitempty"`
+	Tags                      *[]*resourceTag `json:"tags,omitempty"`
+	ConfigServerType          string          `json:"configServerType,omitempty"`
+}
+
+// resourceTag is a key/value pair that Atlas attaches to a cluster or a project, used by the
+// console and billing to organize the resources. Tags are distinct from the legacy labels.
+type resourceTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// setClusterV2Fields sets the fields only exposed by the versioned API. pinned_fcv is cleared
+// when the FCV isn't pinned, e.g. once the pin expired.
+func setClusterV2Fields(d *schema.ResourceData, conn *matlas.Client, projectID, clusterName string) error {
+	root := new(clusterV2Fields)
+	if _, err := doAtlasV2Request(conn, http.MethodGet, fmt.Sprintf(clusterV2Path, projectID, url.PathEscape(clusterName)), nil, root); err != nil {
+		return err
+	}
+	if err := d.Set("pinned_fcv", flattenClusterPinnedFCV(&root.clusterPinnedFCV)); err != nil {
+		return err
+	}
+	if err := d.Set("replica_set_scaling_strategy", root.ReplicaSetScalingStrategy); err != nil {
+		return err
+	}
+	if err := d.Set("config_server_type", root.ConfigServerType); err != nil {
+		return err
+	}
+	return d.Set("tags", flattenResourceTags(root.Tags))
+}
+
+func updateClusterReplicaSetScalingStrategy(conn *matlas.Client, projectID, clusterName, strategy string) error {
+	path := fmt.Sprintf(clusterV2Path, projectID, url.PathEscape(clusterName))
+	_, err := doAtlasV2Request(conn, http.MethodPatch, path, &clusterV2Fields{ReplicaSetScalingStrategy: strategy}, nil)
+	return err
+}
+
+// updateClusterTags replaces the tags of the cluster, an empty list removes them all.
+func updateClusterTags(conn *matlas.Client, projectID, clusterName string, tags *[]*resourceTag) error {
+	path := fmt.Sprintf(clusterV2Path, projectID, url.PathEscape(clusterName))
+	_, err := doAtlasV2Request(conn, http.MethodPatch, path, &clusterV2Fields{Tags: tags}, nil)
+	return err
+}
+
+func resourceTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+// expandResourceTags never returns nil, so that removing every tag from the configuration
+// sends an empty list to Atlas instead of leaving the tags unchanged.
+func expandResourceTags(set *schema.Set) *[]*resourceTag {
+	tags := make([]*resourceTag, 0, set.Len())
+	for _, v := range set.List() {
+		tag := v.(map[string]interface{})
+		tags = append(tags, &resourceTag{
+			Key:   cast.ToString(tag["key"]),
+			Value: cast.ToString(tag["value"]),
+		})
+	}
+	return &tags
+}
+
+func flattenResourceTags(tags *[]*resourceTag) []map[string]interface{} {
+	if tags == nil {
+		return nil
+	}
+	results := make([]map[string]interface{}, 0, len(*tags))
+	for _, tag := range *tags {
+		results = append(results, map[string]interface{}{
+			"key":   tag.Key,
+			"value": tag.Value,
+		})
+	}
+	return results
+}
+
+func flattenClusterPinnedFCV(fcv *clusterPinnedFCV) []map[string]interface{} {
+	if fcv.FeatureCompatibilityVersionExpirationDate == "" {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"version":         fcv.FeatureCompatibilityVersion,
+			"expiration_date": fcv.FeatureCompatibilityVersionExpirationDate,
+		},
+	}
+}
+
+func pinClusterFCV(conn *matlas.Client, projectID, clusterName, expirationDate string) error {
+	path := fmt.Sprintf(clusterV2Path+":pinFeatureCompatibilityVersion", projectID, url.PathEscape(clusterName))
+	_, err := doAtlasV2Request(conn, http.MethodPost, path, map[string]string{"expirationDate": expirationDate}, nil)
+	return err
+}
+
+func unpinClusterFCV(conn *matlas.Client, projectID, clusterName string) error {
+	path := fmt.Sprintf(clusterV2Path+":unpinFeatureCompatibilityVersion", projectID, url.PathEscape(clusterName))
+	_, err := doAtlasV2Request(conn, http.MethodPost, path, nil, nil)
+	return err
+}
+
+// validatePinnedFCVExpirationDate returns an error unless the expiration date is an RFC3339
+// timestamp in the future.
+func validatePinnedFCVExpirationDate(expirationDate string, now time.Time) error {
+	date, err := time.Parse(time.RFC3339, expirationDate)
+	if err != nil {
+		return fmt.Errorf("pinned_fcv.expiration_date must be an RFC3339 timestamp, e.g. 2030-01-01T00:00:00Z: %s", err)
+	}
+	if !date.After(now) {
+		return fmt.Errorf("pinned_fcv.expiration_date (%s) must be in the future", expirationDate)
+	}
+	return nil
+}
+
+// rfc3339DiffSuppressFunc ignores the format differences between a configured timestamp, e.g.
+// the expiration date of the pinned FCV, and the one returned by Atlas.
+func rfc3339DiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	oldDate, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newDate, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldDate.Equal(newDate)
+}
+
+// validateRedactClientLogDataVersion returns an error if the MongoDB major version doesn't
+// support log redaction. An empty version is accepted as Atlas picks the default one.
+func validateRedactClientLogDataVersion(version string) error {
+	if version == "" {
+		return nil
+	}
+	if compareMajorVersions(version, redactClientLogDataMinVersion) < 0 {
+		return fmt.Errorf("redact_client_log_data requires mongo_db_major_version %s or later, got %s", redactClientLogDataMinVersion, version)
+	}
+	return nil
+}
+
+// compareMajorVersions compares two "major.minor" MongoDB versions, returning -1, 0 or 1.
+func compareMajorVersions(a, b string) int {
+	partsA := strings.SplitN(a, ".", 2)
+	partsB := strings.SplitN(b, ".", 2)
+
+	for i := 0; i < 2; i++ {
+		var x, y int
+		if i < len(partsA) {
+			x = cast.ToInt(partsA[i])
+		}
+		if i < len(partsB) {
+			y = cast.ToInt(partsB[i])
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+const cloudProviderRegionsPath = "groups/%s/clusters/provider/regions"
+
+// cloudProviderInstanceSize is an instance size of a cloud provider and the regions in which
+// it's available, as returned by the cloud provider regions endpoint.
+type cloudProviderInstanceSize struct {
+	Name             string `json:"name"`
+	AvailableRegions []struct {
+		Name    string `json:"name"`
+		Default bool   `json:"default"`
+	} `json:"availableRegions"`
+}
+
+// getCloudProviderInstanceSizes returns the instance sizes of the cloud provider available to the
+// project. The options don't change during a run so they are cached in the provider meta.
+func getCloudProviderInstanceSizes(client *MongoDBClient, projectID, providerName string) ([]cloudProviderInstanceSize, error) {
+	path := fmt.Sprintf(cloudProviderRegionsPath+"?providers=%s", projectID, url.QueryEscape(providerName))
+
+	v, err := client.responseCache.Fetch(providerName+":"+path, func() (interface{}, error) {
+		req, err := client.Atlas.NewRequest(context.Background(), http.MethodGet, path, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		root := new(struct {
+			Results []struct {
+				Provider      string                      `json:"provider"`
+				InstanceSizes []cloudProviderInstanceSize `json:"instanceSizes"`
+			} `json:"results"`
+		})
+		if _, err := client.Atlas.Do(context.Background(), req, root); err != nil {
+			return nil, err
+		}
+
+		var instanceSizes []cloudProviderInstanceSize
+		for _, result := range root.Results {
+			if result.Provider == providerName {
+				instanceSizes = append(instanceSizes, result.InstanceSizes...)
+			}
+		}
+		return instanceSizes, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]cloudProviderInstanceSize), nil
+}
+
+// clusterMaxDiskSizeGB is the maximum storage of the instance sizes, i.e. how far Atlas auto-scales
+// the disk of their clusters. The NVMe and tenant instance sizes have a fixed storage.
+// See more: https://docs.atlas.mongodb.com/customize-storage/
+var clusterMaxDiskSizeGB = map[string]float64{
+	"M10":  128,
+	"M20":  256,
+	"M30":  512,
+	"M40":  1024,
+	"R40":  1024,
+	"M50":  4096,
+	"R50":  4096,
+	"M60":  4096,
+	"R60":  4096,
+	"M80":  4096,
+	"R80":  4096,
+	"M140": 4096,
+	"M200": 4096,
+	"R200": 4096,
+	"M300": 4096,
+	"R300": 4096,
+	"R400": 4096,
+	"R700": 4096,
+}
+
+// clusterDiskSizeGBLimit returns the size the disk of the cluster can grow to: the maximum storage
+// of the instance size with disk auto-scaling, the current size otherwise.
+func clusterDiskSizeGBLimit(instanceSize string, autoScalingEnabled bool, diskSizeGB float64) float64 {
+	if !autoScalingEnabled {
+		return diskSizeGB
+	}
+	if limit, ok := clusterMaxDiskSizeGB[instanceSize]; ok && limit > diskSizeGB {
+		return limit
+	}
+	return diskSizeGB
+}
+
+// advancedClusterDiskSizeGBLimit returns the disk size limit of a multi-cloud cluster. The disk size
+// is shared by all the regions, so the smallest instance size sets the ceiling.
+func advancedClusterDiskSizeGBLimit(cluster *advancedCluster) float64 {
+	diskSizeGB := *cluster.DiskSizeGB
+
+	var limit float64
+	for _, spec := range cluster.ReplicationSpecs {
+		for _, region := range spec.RegionConfigs {
+			if region.ElectableSpecs == nil {
+				continue
+			}
+			autoScalingEnabled := region.AutoScaling != nil && region.AutoScaling.DiskGB != nil &&
+				region.AutoScaling.DiskGB.Enabled != nil && *region.AutoScaling.DiskGB.Enabled
+
+			regionLimit := clusterDiskSizeGBLimit(region.ElectableSpecs.InstanceSize, autoScalingEnabled, diskSizeGB)
+			if limit == 0 || regionLimit < limit {
+				limit = regionLimit
+			}
+		}
+	}
+	if limit == 0 {
+		return diskSizeGB
+	}
+	return limit
+}
+
+// validateClusterProviderRegion checks at plan time that the instance size is available in the
+// region of a single region cluster. The check is skipped if the options can't be fetched.
+func validateClusterProviderRegion(d *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*MongoDBClient)
+	if !ok || (d.Id() != "" && !d.HasChange("provider_instance_size_name") && !d.HasChange("provider_region_name")) {
+		return nil
+	}
+	for _, key := range []string{"project_id", "provider_name", "provider_instance_size_name", "provider_region_name"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	projectID := d.Get("project_id").(string)
+	providerName := d.Get("provider_name").(string)
+	instanceSize := d.Get("provider_instance_size_name").(string)
+	regionName, _ := atlasClusterRegionName(providerName, d.Get("provider_region_name").(string))
+
+	if projectID == "" || regionName == "" || providerName == "TENANT" {
+		return nil
+	}
+
+	instanceSizes, err := getCloudProviderInstanceSizes(client, projectID, providerName)
+	if err != nil {
+		log.Printf("[WARN] Error getting the %s provider options of project (%s), skipping validation: %s", providerName, projectID, err)
+		return nil
+	}
+
+	for _, size := range instanceSizes {
+		if size.Name != instanceSize {
+			continue
+		}
+		for _, region := range size.AvailableRegions {
+			if region.Name == regionName {
+				return nil
+			}
+		}
+		return fmt.Errorf("instance size %s isn't available in the %s region %s", instanceSize, providerName, regionName)
+	}
+	return nil
+}
+
+// isSnapshotInProgressError reports whether Atlas rejected a cluster update because a snapshot of
+// the cluster is in progress (CANNOT_UPDATE_CLUSTER_WHILE_SNAPSHOT_IN_PROGRESS).
+func isSnapshotInProgressError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if AtlasErrorCode(err) == "CANNOT_UPDATE_CLUSTER_WHILE_SNAPSHOT_IN_PROGRESS" {
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "cannot_update_cluster_while_snapshot_in_progress") ||
+		(strings.Contains(msg, "snapshot") && strings.Contains(msg, "in progress"))
+}
+
+// isProjectNotFoundError reports whether Atlas rejected a request because the project doesn't exist
+// anymore (GROUP_NOT_FOUND), e.g. when it was deleted in the console. The error code is only
+// available with the provider's client, so the detail of the error is matched too.
+func isProjectNotFoundError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if AtlasErrorCode(err) == "GROUP_NOT_FOUND" {
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "group_not_found") || strings.Contains(msg, "no group with id")
+}
+
+// allowClusterDeletionEnv is the environment variable that allows deleting clusters despite the
+// prevent_cluster_deletion flag of the provider, e.g. for a reviewed decommission.
+const allowClusterDeletionEnv = "MONGODB_ATLAS_ALLOW_CLUSTER_DELETION"
+
+// validateClusterDeletion returns an error if the provider prevents the deletion of clusters and
+// allowClusterDeletionEnv isn't true. It's checked before any request, Atlas may still reject the
+// deletion afterwards, e.g. if the termination protection of the cluster is enabled.
+func validateClusterDeletion(prevent bool, clusterName string) error {
+	if !prevent {
+		return nil
+	}
+	if allowed, _ := strconv.ParseBool(os.Getenv(allowClusterDeletionEnv)); allowed {
+		log.Printf("[WARN] Deleting cluster (%s) despite prevent_cluster_deletion, %s is set", clusterName, allowClusterDeletionEnv)
+		return nil
+	}
+	return fmt.Errorf("error deleting MongoDB Cluster (%s): the provider prevents the deletion of clusters with prevent_cluster_deletion, set the %s environment variable to true to delete it", clusterName, allowClusterDeletionEnv)
+}
+
+// The checks of the Atlas objects that depend on a cluster being deleted, see dependents_on_delete.
+const (
+	clusterDependentsIgnore = "IGNORE"
+	clusterDependentsWarn   = "WARN"
+	clusterDependentsFail   = "FAIL"
+)
+
+const (
+	clusterSearchIndexesV2Path = "../v2/groups/%s/clusters/%s/search/indexes"
+	clusterOnlineArchivesPath  = "groups/%s/clusters/%s/onlineArchives"
+	clusterRestoreJobsPath     = "groups/%s/clusters/%s/backup/restoreJobs"
+)
+
+// checkClusterDependents lists the Atlas objects that depend on the cluster, e.g. search indexes,
+// which are orphaned once it's deleted. They're logged as a warning, or fail the deletion with
+// FAIL, including when they can't be listed.
+func checkClusterDependents(mode, clusterName string, list func() ([]string, error)) error {
+	if mode == clusterDependentsIgnore {
+		return nil
+	}
+
+	dependents, err := list()
+	if err != nil {
+		if mode == clusterDependentsFail {
+			return fmt.Errorf("error deleting MongoDB Cluster (%s): couldn't list the objects that depend on it, set dependents_on_delete to WARN or IGNORE to delete it anyway: %s", clusterName, err)
+		}
+		log.Printf("[WARN] Couldn't list the objects that depend on cluster (%s) before deleting it: %s", clusterName, err)
+		return nil
+	}
+	if len(dependents) == 0 {
+		return nil
+	}
+
+	if mode == clusterDependentsFail {
+		return fmt.Errorf("error deleting MongoDB Cluster (%s): the following objects depend on it and would be orphaned, delete them first or set dependents_on_delete to WARN or IGNORE: %s", clusterName, strings.Join(dependents, ", "))
+	}
+	log.Printf("[WARN] Deleting cluster (%s) orphans the following objects that depend on it: %s", clusterName, strings.Join(dependents, ", "))
+	return nil
+}
+
+// listClusterDependents returns the search indexes, online archives and active restore jobs of
+// the cluster.
+func listClusterDependents(conn *matlas.Client, projectID, clusterName string) ([]string, error) {
+	var dependents []string
+
+	var indexes []*searchIndex
+	resp, err := doAtlasV2Request(conn, http.MethodGet, fmt.Sprintf(clusterSearchIndexesV2Path, projectID, url.PathEscape(clusterName)), nil, &indexes)
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		return nil, fmt.Errorf("error listing search indexes: %s", err)
+	}
+	for _, index := range indexes {
+		dependents = append(dependents, fmt.Sprintf("search index %s (%s.%s)", index.Name, index.Database, index.CollectionName))
+	}
+
+	archives := new(struct {
+		Results []struct {
+			ID       string `json:"_id"`
+			DBName   string `json:"dbName"`
+			CollName string `json:"collName"`
+			State    string `json:"state"`
+		} `json:"results"`
+	})
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, fmt.Sprintf(clusterOnlineArchivesPath, projectID, url.PathEscape(clusterName)), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp, err := conn.Do(context.Background(), req, archives); err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		return nil, fmt.Errorf("error listing online archives: %s", err)
+	}
+	for _, archive := range archives.Results {
+		if archive.State == "DELETED" {
+			continue
+		}
+		dependents = append(dependents, fmt.Sprintf("online archive %s (%s.%s)", archive.ID, archive.DBName, archive.CollName))
+	}
+
+	jobs := new(matlas.CloudProviderSnapshotRestoreJobs)
+	req, err = conn.NewRequest(context.Background(), http.MethodGet, fmt.Sprintf(clusterRestoreJobsPath, projectID, url.PathEscape(clusterName)), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp, err := conn.Do(context.Background(), req, jobs); err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		return nil, fmt.Errorf("error listing restore jobs: %s", err)
+	}
+	for _, job := range jobs.Results {
+		if job.Cancelled || job.Expired || job.FinishedAt != "" {
+			continue
+		}
+		dependents = append(dependents, fmt.Sprintf("restore job %s", job.ID))
+	}
+
+	return dependents, nil
+}
+
+// retryOnSnapshotInProgress calls update, retrying it with backoff until the timeout while Atlas
+// rejects it because a snapshot is in progress. Other errors are returned right away.
+func retryOnSnapshotInProgress(enabled bool, timeout time.Duration, update func() error) error {
+	if !enabled {
+		return update()
+	}
+
+	return resource.Retry(timeout, func() *resource.RetryError {
+		err := update()
+		if isSnapshotInProgressError(err) {
+			log.Printf("[DEBUG] Cluster update rejected while a snapshot is in progress, retrying: %s", err)
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+// clusterRepairingThreshold is how long a cluster may stay in REPAIRING before the wait fails.
+const clusterRepairingThreshold = 45 * time.Minute
+
+// clusterRepairingRefreshFunc wraps a cluster refresh function so that a REPAIRING state is
+// only treated as transient for the given threshold. Atlas briefly reports REPAIRING while
+// it replaces a node, but a cluster that stays in it usually needs manual intervention.
+func clusterRepairingRefreshFunc(name string, threshold time.Duration, refresh resource.StateRefreshFunc) resource.StateRefreshFunc {
+	var repairingSince time.Time
+
+	return func() (interface{}, string, error) {
+		c, state, err := refresh()
+		if err != nil || state != "REPAIRING" {
+			repairingSince = time.Time{}
+			return c, state, err
+		}
+
+		if repairingSince.IsZero() {
+			repairingSince = time.Now()
+		} else if elapsed := time.Since(repairingSince); elapsed >= threshold {
+			return c, state, fmt.Errorf("cluster %s has been in REPAIRING state for %s, this may be caused by a failed "+
+				"node replacement or an issue with the cloud provider, please check the cluster in the Atlas UI or "+
+				"contact MongoDB support", name, elapsed.Round(time.Second))
+		}
+
+		return c, state, nil
+	}
+}
+
+// clusterEmptyStateMaxRetries is how many consecutive reads may return an empty state before
+// the wait fails.
+const clusterEmptyStateMaxRetries = 5
+
+// clusterEmptyStateRefreshFunc wraps a cluster refresh function so that an empty state is
+// reported as the PENDING pseudo-state for up to maxRetries consecutive reads. Atlas briefly
+// returns clusters without a state right after they're created, which would otherwise end the
+// wait with an unexpected state.
+func clusterEmptyStateRefreshFunc(name string, maxRetries int, refresh resource.StateRefreshFunc) resource.StateRefreshFunc {
+	retries := 0
+
+	return func() (interface{}, string, error) {
+		c, state, err := refresh()
+		if err != nil || state != "" {
+			retries = 0
+			return c, state, err
+		}
+
+		retries++
+		if retries > maxRetries {
+			return c, state, fmt.Errorf("cluster %s returned an empty state %d times in a row", name, retries)
+		}
+
+		log.Printf("[DEBUG] empty status for MongoDB cluster %s, retrying (%d/%d)", name, retries, maxRetries)
+		return c, "PENDING", nil
+	}
+}
+
+const (
+	// clusterNetworkErrorMaxRetries is how many consecutive reads may fail with a network error
+	// before the wait fails.
+	clusterNetworkErrorMaxRetries = 5
+	// clusterNetworkErrorBaseDelay is the delay before the first retry of a read that failed with
+	// a network error, it doubles with each consecutive failure.
+	clusterNetworkErrorBaseDelay = 2 * time.Second
+)
+
+// clusterNetworkErrorRefreshFunc wraps a cluster refresh function so that network errors, e.g. DNS
+// resolution failures or TLS handshake timeouts, are retried with an exponential backoff and
+// reported as the REPEATING pseudo-state for up to maxRetries consecutive reads, instead of ending
+// the wait. The errors returned by the API are still fatal. The pseudo-state comes with a result,
+// otherwise the wait would count the retries as reads of a missing cluster and skip its Pending
+// states.
+func clusterNetworkErrorRefreshFunc(name string, maxRetries int, baseDelay time.Duration, refresh resource.StateRefreshFunc) resource.StateRefreshFunc {
+	retries := 0
+
+	return func() (interface{}, string, error) {
+		c, state, err := refresh()
+		if err == nil || !isNetworkError(err) {
+			retries = 0
+			return c, state, err
+		}
+
+		retries++
+		if retries > maxRetries {
+			return nil, "", fmt.Errorf("error reading MongoDB cluster %s, %d network errors in a row: %s", name, retries, err)
+		}
+
+		delay := baseDelay << uint(retries-1)
+		log.Printf("[WARN] network error reading MongoDB cluster %s, retrying in %s (%d/%d): %s", name, delay, retries, maxRetries, err)
+		time.Sleep(delay)
+		return 42, "REPEATING", nil
+	}
+}
+
+// isNetworkError returns true if the request failed before Atlas responded, e.g. because the
+// host couldn't be resolved or the connection timed out.
+func isNetworkError(err error) bool {
+	if urlErr, ok := err.(*url.Error); ok {
+		err = urlErr.Err
+	}
+	_, ok := err.(net.Error)
+	return ok
+}
+
+const advancedClustersPath = "../v1.5/groups/%s/clusters"
+
+// advancedCluster represents a cluster in the advanced clusters API (v1.5), which
+// supports node specs per region and regions from several cloud providers.
+// See more: https://docs.atlas.mongodb.com/reference/api/clusters-advanced/
+type advancedCluster struct {
+	ID                       string                     `json:"id,omitempty"`
+	GroupID                  string                     `json:"groupId,omitempty"`
+	Name                     string                     `json:"name,omitempty"`
+	ClusterType              string                     `json:"clusterType,omitempty"`
+	BackupEnabled            *bool                      `json:"backupEnabled,omitempty"`
+	BiConnector              *matlas.BiConnector        `json:"biConnector,omitempty"`
+	DiskSizeGB               *float64                   `json:"diskSizeGB,omitempty"`
+	EncryptionAtRestProvider string                     `json:"encryptionAtRestProvider,omitempty"`
+	MongoDBMajorVersion      string                     `json:"mongoDBMajorVersion,omitempty"`
+	MongoDBVersion           string                     `json:"mongoDBVersion,omitempty"`
+	RootCertType             string                     `json:"rootCertType,omitempty"`
+	CreateDate               string                     `json:"createDate,omitempty"`
+	Paused                   *bool                      `json:"paused,omitempty"`
+	PitEnabled               *bool                      `json:"pitEnabled,omitempty"`
+	StateName                string                     `json:"stateName,omitempty"`
+	ConnectionStrings        *advancedConnectionStrings `json:"connectionStrings,omitempty"`
+	ReplicationSpecs         []*advancedReplicationSpec `json:"replicationSpecs,omitempty"`
+	Tags                     *[]*resourceTag            `json:"tags,omitempty"`
+
+	AcceptDataRisksAndForceReplicaSetReconfig string `json:"acceptDataRisksAndForceReplicaSetReconfig,omitempty"`
+}
+
+type advancedConnectionStrings struct {
+	Standard    string `json:"standard,omitempty"`
+	StandardSrv string `json:"standardSrv,omitempty"`
+	Private     string `json:"private,omitempty"`
+	PrivateSrv  string `json:"privateSrv,omitempty"`
+}
+
+type advancedReplicationSpec struct {
+	ID            string                  `json:"id,omitempty"`
+	NumShards     int                     `json:"numShards,omitempty"`
+	ZoneName      string                  `json:"zoneName,omitempty"`
+	RegionConfigs []*advancedRegionConfig `json:"regionConfigs,omitempty"`
+}
+
+type advancedRegionConfig struct {
+	ProviderName         string               `json:"providerName,omitempty"`
+	BackingProviderName  string               `json:"backingProviderName,omitempty"`
+	RegionName           string               `json:"regionName,omitempty"`
+	Priority             *int                 `json:"priority,omitempty"`
+	ElectableSpecs       *regionNodeSpecs     `json:"electableSpecs,omitempty"`
+	ReadOnlySpecs        *regionNodeSpecs     `json:"readOnlySpecs,omitempty"`
+	AnalyticsSpecs       *regionNodeSpecs     `json:"analyticsSpecs,omitempty"`
+	AutoScaling          *advancedAutoScaling `json:"autoScaling,omitempty"`
+	AnalyticsAutoScaling *advancedAutoScaling `json:"analyticsAutoScaling,omitempty"`
+}
+
+type regionNodeSpecs struct {
+	InstanceSize  string `json:"instanceSize,omitempty"`
+	NodeCount     *int   `json:"nodeCount,omitempty"`
+	DiskIOPS      *int64 `json:"diskIOPS,omitempty"`
+	EbsVolumeType string `json:"ebsVolumeType,omitempty"`
+}
+
+type advancedAutoScaling struct {
+	DiskGB  *advancedDiskGBAutoScaling  `json:"diskGB,omitempty"`
+	Compute *advancedComputeAutoScaling `json:"compute,omitempty"`
+}
+
+type advancedDiskGBAutoScaling struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+type advancedComputeAutoScaling struct {
+	Enabled          *bool  `json:"enabled,omitempty"`
+	ScaleDownEnabled *bool  `json:"scaleDownEnabled,omitempty"`
+	MinInstanceSize  string `json:"minInstanceSize,omitempty"`
+	MaxInstanceSize  string `json:"maxInstanceSize,omitempty"`
+}
+
+func regionsConfigSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"region_name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateClusterProviderRegionName,
+				DiffSuppressFunc: regionsConfigRegionNameDiffSuppressFunc,
+			},
+			"electable_nodes": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"priority": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"read_only_nodes": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			"analytics_nodes": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			"provider_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"disk_iops": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"electable_specs": regionNodeSpecsSchema(),
+			"analytics_specs": regionNodeSpecsSchema(),
+		},
 	}
+}
 
-	d.SetId(encodeStateID(map[string]string{
-		"cluster_id":   u.ID,
-		"project_id":   projectID,
-		"cluster_name": u.Name,
-	}))
-
-	if err := d.Set("project_id", u.GroupID); err != nil {
-		log.Printf("[WARN] Error setting project_id for (%s): %s", d.Id(), err)
-	}
-	if err := d.Set("name", u.Name); err != nil {
-		log.Printf("[WARN] Error setting name for (%s): %s", d.Id(), err)
+// regionsConfigHash hashes a region of regions_config with the Atlas name of the region, so that a
+// cloud provider name in the configuration matches the Atlas name read from the API.
+func regionsConfigHash(v interface{}) int {
+	region := make(map[string]interface{})
+	for key, value := range v.(map[string]interface{}) {
+		region[key] = value
 	}
-
-	return []*schema.ResourceData{d}, nil
+	region["region_name"] = regionsConfigRegionName(region)
+	return schema.HashResource(regionsConfigSchema())(region)
 }
 
-func expandBiConnector(d *schema.ResourceData) (matlas.BiConnector, error) {
-	var biConnector matlas.BiConnector
+// regionsConfigRegionName returns the Atlas name of the region of a region of regions_config. The
+// regions can set their own provider, so the names of all the providers are looked up.
+func regionsConfigRegionName(region map[string]interface{}) string {
+	regionName, _ := atlasClusterRegionName("", cast.ToString(region["region_name"]))
+	return regionName
+}
 
-	if v, ok := d.GetOk("bi_connector"); ok {
-		biConnMap := v.(map[string]interface{})
+// regionsConfigRegionNameDiffSuppressFunc suppresses the diff between the cloud provider name of a
+// region of regions_config and its Atlas name read from the API.
+func regionsConfigRegionNameDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	atlasName, ok := atlasClusterRegionName("", new)
+	return ok && atlasName == old
+}
 
-		enabled := cast.ToBool(biConnMap["enabled"])
+func regionNodeSpecsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"instance_size": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
 
-		biConnector = matlas.BiConnector{
-			Enabled:        &enabled,
-			ReadPreference: cast.ToString(biConnMap["read_preference"]),
+// isMultiCloudCluster returns true if any region of the cluster sets its own provider.
+func isMultiCloudCluster(d *schema.ResourceData) bool {
+	for _, s := range d.Get("replication_specs").([]interface{}) {
+		spec, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		regions, ok := spec["regions_config"].(*schema.Set)
+		if !ok {
+			continue
+		}
+		for _, r := range regions.List() {
+			if cast.ToString(r.(map[string]interface{})["provider_name"]) != "" {
+				return true
+			}
 		}
 	}
-	return biConnector, nil
+	return false
 }
 
-func flattenBiConnector(biConnector matlas.BiConnector) map[string]interface{} {
-	biConnectorMap := make(map[string]interface{})
+// expandAdvancedClusterFromCluster translates the cluster configuration to the advanced
+// clusters API shape. Regions without their own provider or instance sizes inherit the
+// top level provider settings.
+func expandAdvancedClusterFromCluster(d *schema.ResourceData, cluster *matlas.Cluster) (*advancedCluster, error) {
+	providerName := d.Get("provider_name").(string)
+	instanceSize := d.Get("provider_instance_size_name").(string)
 
-	if biConnector.Enabled != nil {
-		biConnectorMap["enabled"] = strconv.FormatBool(*biConnector.Enabled)
+	advancedRequest := &advancedCluster{
+		Name:                     d.Get("name").(string),
+		ClusterType:              cluster.ClusterType,
+		BackupEnabled:            cluster.BackupEnabled,
+		DiskSizeGB:               cluster.DiskSizeGB,
+		EncryptionAtRestProvider: cluster.EncryptionAtRestProvider,
+		MongoDBMajorVersion:      cluster.MongoDBMajorVersion,
+		ReplicationSpecs:         make([]*advancedReplicationSpec, 0),
 	}
-
-	if biConnector.ReadPreference != "" {
-		biConnectorMap["read_preference"] = biConnector.ReadPreference
+	if cluster.BiConnector.Enabled != nil {
+		advancedRequest.BiConnector = &cluster.BiConnector
 	}
 
-	return biConnectorMap
-}
+	for i, s := range d.Get("replication_specs").([]interface{}) {
+		spec := s.(map[string]interface{})
 
-func expandProviderSetting(d *schema.ResourceData) matlas.ProviderSettings {
-	diskIOPS := cast.ToInt64(d.Get("provider_disk_iops"))
-	encryptEBSVolume := cast.ToBool(d.Get("provider_encrypt_ebs_volume"))
-	region, _ := valRegion(d.Get("provider_region_name"))
+		rSpec := &advancedReplicationSpec{
+			ID:            replicationSpecID(d, i, spec),
+			NumShards:     cast.ToInt(spec["num_shards"]),
+			ZoneName:      cast.ToString(spec["zone_name"]),
+			RegionConfigs: make([]*advancedRegionConfig, 0),
+		}
 
-	providerSettings := matlas.ProviderSettings{
-		DiskIOPS:            &diskIOPS,
-		EncryptEBSVolume:    &encryptEBSVolume,
-		BackingProviderName: cast.ToString(d.Get("backing_provider_name")),
-		DiskTypeName:        cast.ToString(d.Get("provider_disk_type_name")),
-		InstanceSizeName:    cast.ToString(d.Get("provider_instance_size_name")),
-		ProviderName:        cast.ToString(d.Get("provider_name")),
-		RegionName:          region,
-		VolumeType:          cast.ToString(d.Get("provider_volume_type")),
-	}
+		for _, r := range spec["regions_config"].(*schema.Set).List() {
+			region := r.(map[string]interface{})
 
-	return providerSettings
-}
+			regionName, err := valRegion(regionsConfigRegionName(region))
+			if err != nil {
+				return nil, err
+			}
 
-func flattenProviderSettings(d *schema.ResourceData, settings matlas.ProviderSettings) {
-	if err := d.Set("backing_provider_name", settings.BackingProviderName); err != nil {
-		log.Printf("[WARN] error setting cluster `backing_provider_name`: %s", err)
-	}
+			regionProvider := cast.ToString(region["provider_name"])
+			if regionProvider == "" {
+				regionProvider = providerName
+			}
 
-	if settings.DiskIOPS != nil && *settings.DiskIOPS != 0 {
-		if err := d.Set("provider_disk_iops", *settings.DiskIOPS); err != nil {
-			log.Printf("[WARN] error setting cluster `disk_iops`: %s", err)
+			electableSize := expandRegionInstanceSize(region["electable_specs"], instanceSize)
+
+			// Like provider_disk_iops, the IOPS are left to Atlas unless they're set.
+			var diskIOPS *int64
+			if v := cast.ToInt64(region["disk_iops"]); v > 0 {
+				diskIOPS = &v
+			}
+
+			regionConfig := &advancedRegionConfig{
+				ProviderName: regionProvider,
+				RegionName:   regionName,
+				Priority:     pointy.Int(cast.ToInt(region["priority"])),
+				ElectableSpecs: &regionNodeSpecs{
+					InstanceSize: electableSize,
+					NodeCount:    pointy.Int(cast.ToInt(region["electable_nodes"])),
+					DiskIOPS:     diskIOPS,
+				},
+				ReadOnlySpecs: &regionNodeSpecs{
+					InstanceSize: electableSize,
+					NodeCount:    pointy.Int(cast.ToInt(region["read_only_nodes"])),
+					DiskIOPS:     diskIOPS,
+				},
+				AnalyticsSpecs: &regionNodeSpecs{
+					InstanceSize: expandRegionInstanceSize(region["analytics_specs"], electableSize),
+					NodeCount:    pointy.Int(cast.ToInt(region["analytics_nodes"])),
+					DiskIOPS:     diskIOPS,
+				},
+			}
+			rSpec.RegionConfigs = append(rSpec.RegionConfigs, regionConfig)
 		}
-	}
 
-	if err := d.Set("provider_disk_type_name", settings.DiskTypeName); err != nil {
-		log.Printf("[WARN] error setting cluster `disk_type_name`: %s", err)
+		advancedRequest.ReplicationSpecs = append(advancedRequest.ReplicationSpecs, rSpec)
 	}
 
-	if err := d.Set("provider_encrypt_ebs_volume", settings.EncryptEBSVolume); err != nil {
-		log.Printf("[WARN] error setting cluster `encrypt_ebs_volume`: %s", err)
-	}
+	return advancedRequest, nil
+}
 
-	if err := d.Set("provider_instance_size_name", settings.InstanceSizeName); err != nil {
-		log.Printf("[WARN] error setting cluster `instance_size_name`: %s", err)
+func expandRegionInstanceSize(specs interface{}, defaultSize string) string {
+	if l, ok := specs.([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		if size := cast.ToString(l[0].(map[string]interface{})["instance_size"]); size != "" {
+			return size
+		}
 	}
+	return defaultSize
+}
 
-	if err := d.Set("provider_name", settings.ProviderName); err != nil {
-		log.Printf("[WARN] error setting cluster `provider_name`: %s", err)
+// countAdvancedReplicationSpecsNodes is countReplicationSpecsNodes for the multi-cloud clusters.
+func countAdvancedReplicationSpecsNodes(rSpecs []*advancedReplicationSpec) map[string]int64 {
+	counts := map[string]int64{
+		"total_electable_nodes": 0,
+		"total_read_only_nodes": 0,
+		"total_analytics_nodes": 0,
 	}
-
-	if err := d.Set("provider_region_name", settings.RegionName); err != nil {
-		log.Printf("[WARN] error setting cluster `region_name`: %s", err)
+	for _, rSpec := range rSpecs {
+		numShards := int64(1)
+		if rSpec.NumShards > 0 {
+			numShards = int64(rSpec.NumShards)
+		}
+		for _, regionConfig := range rSpec.RegionConfigs {
+			counts["total_electable_nodes"] += numShards * regionNodeCount(regionConfig.ElectableSpecs)
+			counts["total_read_only_nodes"] += numShards * regionNodeCount(regionConfig.ReadOnlySpecs)
+			counts["total_analytics_nodes"] += numShards * regionNodeCount(regionConfig.AnalyticsSpecs)
+		}
 	}
+	return counts
+}
 
-	if err := d.Set("provider_volume_type", settings.VolumeType); err != nil {
-		log.Printf("[WARN] error setting cluster `volume_type`: %s", err)
+func regionNodeCount(specs *regionNodeSpecs) int64 {
+	if specs == nil || specs.NodeCount == nil {
+		return 0
 	}
+	return int64(*specs.NodeCount)
 }
 
-func expandReplicationSpecs(d *schema.ResourceData) ([]matlas.ReplicationSpec, error) {
-	rSpecs := make([]matlas.ReplicationSpec, 0)
-
-	if v, ok := d.GetOk("replication_specs"); ok {
-		for _, s := range v.([]interface{}) {
-			spec := s.(map[string]interface{})
-
-			regionsConfig, err := expandRegionsConfig(spec["regions_config"].(*schema.Set).List())
-			if err != nil {
-				return rSpecs, err
+// flattenAdvancedReplicationSpecs flattens the replication specs of a multi-cloud cluster. The disk
+// IOPS of a region are only read when they're set in the declared replication specs, otherwise the
+// IOPS that Atlas assigned would make a diff.
+func flattenAdvancedReplicationSpecs(rSpecs []*advancedReplicationSpec, declared []interface{}) []map[string]interface{} {
+	specs := make([]map[string]interface{}, 0)
+	for i, rSpec := range rSpecs {
+		declaredIOPS := declaredRegionsDiskIOPS(declared, i)
+
+		regions := make([]map[string]interface{}, 0)
+		for _, regionConfig := range rSpec.RegionConfigs {
+			region := map[string]interface{}{
+				"region_name":   regionConfig.RegionName,
+				"provider_name": regionConfig.ProviderName,
+				"priority":      regionConfig.Priority,
+				"disk_iops":     0,
 			}
-
-			rSpec := matlas.ReplicationSpec{
-				ID:            cast.ToString(spec["id"]),
-				NumShards:     pointy.Int64(cast.ToInt64(spec["num_shards"])),
-				ZoneName:      cast.ToString(spec["zone_name"]),
-				RegionsConfig: regionsConfig,
+			if specs := regionConfig.ElectableSpecs; specs != nil {
+				if specs.DiskIOPS != nil && declaredIOPS[regionConfig.RegionName] {
+					region["disk_iops"] = *specs.DiskIOPS
+				}
+				region["electable_nodes"] = specs.NodeCount
+				region["electable_specs"] = []map[string]interface{}{{"instance_size": specs.InstanceSize}}
 			}
-			rSpecs = append(rSpecs, rSpec)
+			if specs := regionConfig.ReadOnlySpecs; specs != nil {
+				region["read_only_nodes"] = specs.NodeCount
+			}
+			if specs := regionConfig.AnalyticsSpecs; specs != nil {
+				region["analytics_nodes"] = specs.NodeCount
+				region["analytics_specs"] = []map[string]interface{}{{"instance_size": specs.InstanceSize}}
+			}
+			regions = append(regions, region)
 		}
-	}
-
-	return rSpecs, nil
-}
 
-func flattenReplicationSpecs(rSpecs []matlas.ReplicationSpec) []map[string]interface{} {
-	specs := make([]map[string]interface{}, 0)
-	for _, rSpec := range rSpecs {
-		spec := map[string]interface{}{
+		specs = append(specs, map[string]interface{}{
 			"id":             rSpec.ID,
 			"num_shards":     rSpec.NumShards,
 			"zone_name":      rSpec.ZoneName,
-			"regions_config": flattenRegionsConfig(rSpec.RegionsConfig),
-		}
-		specs = append(specs, spec)
+			"regions_config": regions,
+		})
 	}
 	return specs
 }
 
-func expandRegionsConfig(regions []interface{}) (map[string]matlas.RegionsConfig, error) {
-	regionsConfig := make(map[string]matlas.RegionsConfig)
-	for _, r := range regions {
-		region := r.(map[string]interface{})
-
-		r, err := valRegion(region["region_name"])
-		if err != nil {
-			return regionsConfig, err
-		}
-
-		regionsConfig[r] = matlas.RegionsConfig{
-			AnalyticsNodes: pointy.Int64(cast.ToInt64(region["analytics_nodes"])),
-			ElectableNodes: pointy.Int64(cast.ToInt64(region["electable_nodes"])),
-			Priority:       pointy.Int64(cast.ToInt64(region["priority"])),
-			ReadOnlyNodes:  pointy.Int64(cast.ToInt64(region["read_only_nodes"])),
+// declaredRegionsDiskIOPS returns the regions that set disk_iops in the i-th declared replication spec.
+func declaredRegionsDiskIOPS(declared []interface{}, i int) map[string]bool {
+	regions := make(map[string]bool)
+	if i >= len(declared) {
+		return regions
+	}
+	spec, ok := declared[i].(map[string]interface{})
+	if !ok {
+		return regions
+	}
+	set, ok := spec["regions_config"].(*schema.Set)
+	if !ok {
+		return regions
+	}
+	for _, r := range set.List() {
+		if region := r.(map[string]interface{}); cast.ToInt(region["disk_iops"]) > 0 {
+			regions[regionsConfigRegionName(region)] = true
 		}
 	}
-	return regionsConfig, nil
+	return regions
 }
 
-func flattenRegionsConfig(regionsConfig map[string]matlas.RegionsConfig) []map[string]interface{} {
-	regions := make([]map[string]interface{}, 0)
+func resourceMongoDBAtlasClusterReadAdvanced(d *schema.ResourceData, conn *matlas.Client, projectID, clusterName string) error {
+	cluster, resp, err := getAdvancedCluster(conn, projectID, clusterName)
+	if err != nil {
+		if isProjectNotFoundError(err) {
+			log.Printf("[WARN] the project (%s) of cluster (%s) no longer exists, removing the cluster from the state", projectID, clusterName)
+			d.SetId("")
+			return nil
+		}
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return fmt.Errorf(errorRead, clusterName, err)
+	}
 
-	for regionName, regionConfig := range regionsConfig {
-		region := map[string]interface{}{
-			"region_name":     regionName,
-			"priority":        regionConfig.Priority,
-			"analytics_nodes": regionConfig.AnalyticsNodes,
-			"electable_nodes": regionConfig.ElectableNodes,
-			"read_only_nodes": regionConfig.ReadOnlyNodes,
+	if err := d.Set("cluster_id", cluster.ID); err != nil {
+		return fmt.Errorf(errorRead, clusterName, err)
+	}
+	if err := d.Set("backup_enabled", cluster.BackupEnabled); err != nil {
+		return fmt.Errorf(errorRead, clusterName, err)
+	}
+	if err := d.Set("cluster_type", cluster.ClusterType); err != nil {
+		return fmt.Errorf(errorRead, clusterName, err)
+	}
+	if err := d.Set("disk_size_gb", cluster.DiskSizeGB); err != nil {
+		return fmt.Errorf(errorRead, clusterName, err)
+	}
+	if cluster.DiskSizeGB != nil {
+		if err := d.Set("disk_size_gb_limit", advancedClusterDiskSizeGBLimit(cluster)); err != nil {
+			return fmt.Errorf(errorRead, clusterName, err)
 		}
-		regions = append(regions, region)
 	}
-	return regions
+	if err := d.Set("encryption_at_rest_provider", cluster.EncryptionAtRestProvider); err != nil {
+		return fmt.Errorf(errorRead, clusterName, err)
+	}
+	if err := d.Set("mongo_db_major_version", cluster.MongoDBMajorVersion); err != nil {
+		return fmt.Errorf(errorRead, clusterName, err)
+	}
+	//The version and creation date are empty while the cluster is being created.
+	if cluster.MongoDBVersion != "" {
+		if err := d.Set("mongo_db_version", cluster.MongoDBVersion); err != nil {
+			return fmt.Errorf(errorRead, clusterName, err)
+		}
+	}
+	if err := d.Set("root_cert_type", cluster.RootCertType); err != nil {
+		return fmt.Errorf(errorRead, clusterName, err)
+	}
+	if cluster.CreateDate != "" {
+		if err := d.Set("create_date", cluster.CreateDate); err != nil {
+			return fmt.Errorf(errorRead, clusterName, err)
+		}
+	}
+	if cluster.ConnectionStrings != nil {
+		if err := d.Set("mongo_uri", cluster.ConnectionStrings.Standard); err != nil {
+			return fmt.Errorf(errorRead, clusterName, err)
+		}
+		if err := d.Set("hosts", mongoURIHosts(cluster.ConnectionStrings.Standard)); err != nil {
+			return fmt.Errorf(errorRead, clusterName, err)
+		}
+		if err := setClusterProcessIDs(d, conn, projectID, cluster.ConnectionStrings.Standard); err != nil {
+			return fmt.Errorf(errorRead, clusterName, err)
+		}
+		if err := d.Set("srv_address", cluster.ConnectionStrings.StandardSrv); err != nil {
+			return fmt.Errorf(errorRead, clusterName, err)
+		}
+	}
+	if err := setClusterConnectionStrings(d, cluster.ConnectionStrings); err != nil {
+		return fmt.Errorf(errorRead, clusterName, err)
+	}
+	if err := d.Set("paused", cluster.Paused); err != nil {
+		return fmt.Errorf(errorRead, clusterName, err)
+	}
+	if err := d.Set("state_name", cluster.StateName); err != nil {
+		return fmt.Errorf(errorRead, clusterName, err)
+	}
+	if cluster.BiConnector != nil {
+		if err := d.Set("bi_connector", flattenBiConnector(*cluster.BiConnector)); err != nil {
+			return fmt.Errorf(errorRead, clusterName, err)
+		}
+	}
+	if err := d.Set("replication_specs", flattenAdvancedReplicationSpecs(cluster.ReplicationSpecs, d.Get("replication_specs").([]interface{}))); err != nil {
+		return fmt.Errorf(errorRead, clusterName, err)
+	}
+	if err := setClusterNodeCounts(d, countAdvancedReplicationSpecsNodes(cluster.ReplicationSpecs)); err != nil {
+		return fmt.Errorf(errorRead, clusterName, err)
+	}
+	if err := setClusterV2Fields(d, conn, projectID, clusterName); err != nil {
+		return fmt.Errorf(errorRead, clusterName, err)
+	}
+	if err := setClusterAdvancedConfiguration(d, conn, projectID, clusterName); err != nil {
+		return fmt.Errorf(errorRead, clusterName, err)
+	}
+
+	return nil
 }
 
-func resourceClusterRefreshFunc(name, projectID string, client *matlas.Client) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		c, resp, err := client.Clusters.Get(context.Background(), projectID, name)
+func advancedClusterRefreshFunc(name, projectID string, client *matlas.Client) resource.StateRefreshFunc {
+	return clusterRepairingRefreshFunc(name, clusterRepairingThreshold, clusterEmptyStateRefreshFunc(name, clusterEmptyStateMaxRetries, clusterNetworkErrorRefreshFunc(name, clusterNetworkErrorMaxRetries, clusterNetworkErrorBaseDelay, func() (interface{}, string, error) {
+		c, resp, err := getAdvancedCluster(client, projectID, name)
 
 		if err != nil && strings.Contains(err.Error(), "reset by peer") {
 			return nil, "REPEATING", nil
@@ -750,5 +3945,53 @@ func resourceClusterRefreshFunc(name, projectID string, client *matlas.Client) r
 		}
 
 		return c, c.StateName, nil
+	})))
+}
+
+func getAdvancedCluster(conn *matlas.Client, projectID, clusterName string) (*advancedCluster, *matlas.Response, error) {
+	path := fmt.Sprintf(advancedClustersPath+"/%s", projectID, url.PathEscape(clusterName))
+
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(advancedCluster)
+	resp, err := conn.Do(context.Background(), req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
+
+func createAdvancedCluster(conn *matlas.Client, projectID string, cluster *advancedCluster) (*advancedCluster, *matlas.Response, error) {
+	path := fmt.Sprintf(advancedClustersPath, projectID)
+
+	req, err := conn.NewRequest(context.Background(), http.MethodPost, path, cluster)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(advancedCluster)
+	resp, err := conn.Do(context.Background(), req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
+
+func updateAdvancedCluster(conn *matlas.Client, projectID, clusterName string, cluster *advancedCluster) (*advancedCluster, *matlas.Response, error) {
+	path := fmt.Sprintf(advancedClustersPath+"/%s", projectID, url.PathEscape(clusterName))
+
+	req, err := conn.NewRequest(context.Background(), http.MethodPatch, path, cluster)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(advancedCluster)
+	resp, err := conn.Do(context.Background(), req, root)
+	if err != nil {
+		return nil, resp, err
 	}
+	return root, resp, nil
 }
@@ -0,0 +1,135 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+const (
+	clusterRestartPrimariesPath     = "groups/%s/clusters/%s/restartPrimaries"
+	errorClusterTestFailoverCreate  = "error testing failover of cluster (%s): %s"
+	errorClusterTestFailoverSetting = "error setting `%s` for failover test of cluster (%s): %s"
+)
+
+func resourceMongoDBAtlasClusterTestFailover() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMongoDBAtlasClusterTestFailoverCreate,
+		Read:   resourceMongoDBAtlasClusterTestFailoverRead,
+		Delete: resourceMongoDBAtlasClusterTestFailoverDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Hour),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"started_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"finished_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"duration_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMongoDBAtlasClusterTestFailoverCreate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+	clusterName := d.Get("cluster_name").(string)
+
+	startedAt := time.Now().UTC()
+	if err := triggerClusterTestFailover(conn, projectID, clusterName); err != nil {
+		return fmt.Errorf(errorClusterTestFailoverCreate, clusterName, err)
+	}
+
+	refresh := resourceClusterRefreshFunc(clusterName, projectID, conn)
+	if err := waitForClusterTestFailover(refresh, d.Timeout(schema.TimeoutCreate), 30*time.Second, 15*time.Second); err != nil {
+		return fmt.Errorf(errorClusterTestFailoverCreate, clusterName, err)
+	}
+	finishedAt := time.Now().UTC()
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":   projectID,
+		"cluster_name": clusterName,
+		"started_at":   startedAt.Format(time.RFC3339),
+	}))
+
+	if err := d.Set("started_at", startedAt.Format(time.RFC3339)); err != nil {
+		return fmt.Errorf(errorClusterTestFailoverSetting, "started_at", clusterName, err)
+	}
+	if err := d.Set("finished_at", finishedAt.Format(time.RFC3339)); err != nil {
+		return fmt.Errorf(errorClusterTestFailoverSetting, "finished_at", clusterName, err)
+	}
+	if err := d.Set("duration_seconds", int(finishedAt.Sub(startedAt).Seconds())); err != nil {
+		return fmt.Errorf(errorClusterTestFailoverSetting, "duration_seconds", clusterName, err)
+	}
+
+	return resourceMongoDBAtlasClusterTestFailoverRead(d, meta)
+}
+
+// The failover test is a one-shot action, its state only records the last run.
+func resourceMongoDBAtlasClusterTestFailoverRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceMongoDBAtlasClusterTestFailoverDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+// triggerClusterTestFailover steps down the primary of the cluster, or of each shard of a sharded
+// cluster, so that an election promotes a secondary.
+func triggerClusterTestFailover(conn *matlas.Client, projectID, clusterName string) error {
+	req, err := conn.NewRequest(context.Background(), http.MethodPost, fmt.Sprintf(clusterRestartPrimariesPath, projectID, url.PathEscape(clusterName)), nil)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do(context.Background(), req, nil)
+	return err
+}
+
+// waitForClusterTestFailover waits until the cluster is IDLE again after the failover. The wait
+// starts after the delay, since Atlas may still report the cluster as IDLE right after the request.
+func waitForClusterTestFailover(refresh resource.StateRefreshFunc, timeout, delay, pollInterval time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"UPDATING", "REPAIRING", "REPEATING", "PENDING"},
+		Target:       []string{"IDLE"},
+		Refresh:      refresh,
+		Timeout:      timeout,
+		Delay:        delay,
+		PollInterval: pollInterval,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("the cluster didn't recover from the failover: %s", err)
+	}
+	return nil
+}
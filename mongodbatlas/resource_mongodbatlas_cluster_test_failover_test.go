@@ -0,0 +1,95 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+func TestAccResourceMongoDBAtlasClusterTestFailover_basic(t *testing.T) {
+	resourceName := "mongodbatlas_cluster_test_failover.test"
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+	clusterName := os.Getenv("MONGODB_ATLAS_CLUSTER_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); checkClusterEnv(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasClusterTestFailoverConfig(projectID, clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "started_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "finished_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "duration_seconds"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMongoDBAtlasClusterTestFailoverConfig(projectID, clusterName string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_cluster_test_failover" "test" {
+			project_id   = "%s"
+			cluster_name = "%s"
+		}
+	`, projectID, clusterName)
+}
+
+func TestTriggerClusterTestFailover(t *testing.T) {
+	var requests []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if err := triggerClusterTestFailover(client, "5d09d6a59ccf6445652a444a", "cluster0"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := []string{"POST /groups/5d09d6a59ccf6445652a444a/clusters/cluster0/restartPrimaries"}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Fatalf("expected requests %v, got %v", expected, requests)
+	}
+}
+
+func TestWaitForClusterTestFailover(t *testing.T) {
+	states := []string{"UPDATING", "REPAIRING", "IDLE"}
+	var calls int32
+	refresh := func() (interface{}, string, error) {
+		i := atomic.AddInt32(&calls, 1) - 1
+		if int(i) >= len(states) {
+			return 42, states[len(states)-1], nil
+		}
+		return 42, states[i], nil
+	}
+
+	if err := waitForClusterTestFailover(refresh, 5*time.Second, 0, time.Millisecond); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if calls != 3 {
+		t.Fatalf("expected to wait until the cluster was IDLE, got %d calls", calls)
+	}
+
+	updating := func() (interface{}, string, error) {
+		return 42, "UPDATING", nil
+	}
+	if err := waitForClusterTestFailover(updating, 50*time.Millisecond, 0, time.Millisecond); err == nil {
+		t.Fatal("expected an error when the cluster doesn't recover")
+	}
+}
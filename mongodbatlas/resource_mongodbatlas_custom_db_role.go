@@ -0,0 +1,425 @@
+package mongodbatlas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+const (
+	customDBRolesPath = "groups/%s/customDBRoles/roles"
+
+	errorCustomDBRoleCreate  = "error creating custom db role (%s): %s"
+	errorCustomDBRoleRead    = "error getting custom db role information (%s): %s"
+	errorCustomDBRoleUpdate  = "error updating custom db role (%s): %s"
+	errorCustomDBRoleDelete  = "error deleting custom db role (%s): %s"
+	errorCustomDBRoleSetting = "error setting `%s` for custom db role (%s): %s"
+)
+
+// customDBRole is a MongoDB role with the privileges, and the roles it inherits, defined by the user.
+// See more: https://docs.atlas.mongodb.com/reference/api/custom-roles/
+type customDBRole struct {
+	RoleName       string                  `json:"roleName,omitempty"`
+	Actions        []customDBRoleAction    `json:"actions"`
+	InheritedRoles []customDBInheritedRole `json:"inheritedRoles"`
+}
+
+type customDBRoleAction struct {
+	Action    string                 `json:"action"`
+	Resources []customDBRoleResource `json:"resources,omitempty"`
+}
+
+// customDBRoleResource is either a cluster or a collection, an empty collection name stands for
+// all the collections of the database.
+type customDBRoleResource struct {
+	Cluster    *bool   `json:"cluster,omitempty"`
+	DB         *string `json:"db,omitempty"`
+	Collection *string `json:"collection,omitempty"`
+}
+
+type customDBInheritedRole struct {
+	Role string `json:"role"`
+	DB   string `json:"db"`
+}
+
+func resourceMongoDBAtlasCustomDBRole() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMongoDBAtlasCustomDBRoleCreate,
+		Read:   resourceMongoDBAtlasCustomDBRoleRead,
+		Update: resourceMongoDBAtlasCustomDBRoleUpdate,
+		Delete: resourceMongoDBAtlasCustomDBRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMongoDBAtlasCustomDBRoleImportState,
+		},
+		CustomizeDiff: resourceMongoDBAtlasCustomDBRoleCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"actions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"resources": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"collection_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"database_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"cluster": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"inherited_roles": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceMongoDBAtlasCustomDBRoleCreate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+	roleName := d.Get("role_name").(string)
+
+	role := expandCustomDBRole(d)
+	role.RoleName = roleName
+
+	if err := validateCustomDBRoleInheritance(conn, projectID, roleName, role.InheritedRoles); err != nil {
+		return fmt.Errorf(errorCustomDBRoleCreate, roleName, err)
+	}
+
+	req, err := conn.NewRequest(context.Background(), http.MethodPost, fmt.Sprintf(customDBRolesPath, projectID), role)
+	if err == nil {
+		_, err = conn.Do(context.Background(), req, nil)
+	}
+	if err != nil {
+		return fmt.Errorf(errorCustomDBRoleCreate, roleName, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id": projectID,
+		"role_name":  roleName,
+	}))
+
+	return resourceMongoDBAtlasCustomDBRoleRead(d, meta)
+}
+
+func resourceMongoDBAtlasCustomDBRoleRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	roleName := ids["role_name"]
+
+	role, resp, err := getCustomDBRole(conn, ids["project_id"], roleName)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(errorCustomDBRoleRead, roleName, err)
+	}
+
+	if err := d.Set("role_name", role.RoleName); err != nil {
+		return fmt.Errorf(errorCustomDBRoleSetting, "role_name", roleName, err)
+	}
+	if err := d.Set("actions", flattenCustomDBRoleActions(role.Actions)); err != nil {
+		return fmt.Errorf(errorCustomDBRoleSetting, "actions", roleName, err)
+	}
+	if err := d.Set("inherited_roles", flattenCustomDBInheritedRoles(role.InheritedRoles)); err != nil {
+		return fmt.Errorf(errorCustomDBRoleSetting, "inherited_roles", roleName, err)
+	}
+
+	return nil
+}
+
+func resourceMongoDBAtlasCustomDBRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	projectID := ids["project_id"]
+	roleName := ids["role_name"]
+
+	role := expandCustomDBRole(d)
+
+	if d.HasChange("inherited_roles") {
+		if err := validateCustomDBRoleInheritance(conn, projectID, roleName, role.InheritedRoles); err != nil {
+			return fmt.Errorf(errorCustomDBRoleUpdate, roleName, err)
+		}
+	}
+
+	req, err := conn.NewRequest(context.Background(), http.MethodPatch, customDBRolePath(projectID, roleName), role)
+	if err == nil {
+		_, err = conn.Do(context.Background(), req, nil)
+	}
+	if err != nil {
+		return fmt.Errorf(errorCustomDBRoleUpdate, roleName, err)
+	}
+
+	return resourceMongoDBAtlasCustomDBRoleRead(d, meta)
+}
+
+func resourceMongoDBAtlasCustomDBRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	roleName := ids["role_name"]
+
+	req, err := conn.NewRequest(context.Background(), http.MethodDelete, customDBRolePath(ids["project_id"], roleName), nil)
+	if err == nil {
+		_, err = conn.Do(context.Background(), req, nil)
+	}
+	if err != nil {
+		return fmt.Errorf(errorCustomDBRoleDelete, roleName, err)
+	}
+	return nil
+}
+
+func resourceMongoDBAtlasCustomDBRoleImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*MongoDBClient).Atlas
+
+	parts := strings.SplitN(d.Id(), "-", 2)
+	if len(parts) != 2 {
+		return nil, errors.New("import format error: to import a custom db role, use the format {project_id}-{role_name}")
+	}
+
+	projectID := parts[0]
+	roleName := parts[1]
+
+	if _, _, err := getCustomDBRole(conn, projectID, roleName); err != nil {
+		return nil, fmt.Errorf("couldn't import custom db role %s in project %s, error: %s", roleName, projectID, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id": projectID,
+		"role_name":  roleName,
+	}))
+
+	if err := d.Set("project_id", projectID); err != nil {
+		log.Printf("[WARN] Error setting project_id for (%s): %s", d.Id(), err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceMongoDBAtlasCustomDBRoleCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("role_name") || !d.NewValueKnown("inherited_roles") {
+		return nil
+	}
+
+	//Only a role that inherits itself can be detected from the plan, the inheritance of the
+	//other roles is checked against Atlas when the role is created or updated.
+	roleName := d.Get("role_name").(string)
+	inherited := map[string][]customDBInheritedRole{
+		roleName: expandCustomDBInheritedRoles(d.Get("inherited_roles").(*schema.Set)),
+	}
+	if cycle := findCustomDBRoleCycle(roleName, inherited); cycle != nil {
+		return circularRoleInheritanceError(cycle)
+	}
+	return nil
+}
+
+// validateCustomDBRoleInheritance returns an error naming the roles involved if the inherited
+// roles would make the role inherit itself through the custom roles of the project, Atlas rejects
+// those with an error that doesn't say which roles form the cycle.
+func validateCustomDBRoleInheritance(conn *matlas.Client, projectID, roleName string, inheritedRoles []customDBInheritedRole) error {
+	roles, err := listCustomDBRoles(conn, projectID)
+	if err != nil {
+		return err
+	}
+
+	inherited := make(map[string][]customDBInheritedRole, len(roles)+1)
+	for _, role := range roles {
+		inherited[role.RoleName] = role.InheritedRoles
+	}
+	inherited[roleName] = inheritedRoles
+
+	if cycle := findCustomDBRoleCycle(roleName, inherited); cycle != nil {
+		return circularRoleInheritanceError(cycle)
+	}
+	return nil
+}
+
+// findCustomDBRoleCycle returns the chain of roles that leads from the role back to itself, e.g.
+// [a b a], or nil if the role doesn't inherit itself. Custom roles are defined in the admin
+// database, the roles inherited from other databases are built-in roles.
+func findCustomDBRoleCycle(roleName string, inherited map[string][]customDBInheritedRole) []string {
+	visited := make(map[string]bool)
+
+	var visit func(path []string) []string
+	visit = func(path []string) []string {
+		current := path[len(path)-1]
+		for _, r := range inherited[current] {
+			if r.DB != adminAuthDatabase {
+				continue
+			}
+			if r.Role == roleName {
+				return append(path, r.Role)
+			}
+			if _, ok := inherited[r.Role]; !ok || visited[r.Role] {
+				continue
+			}
+			visited[r.Role] = true
+			if cycle := visit(append(path[:len(path):len(path)], r.Role)); cycle != nil {
+				return cycle
+			}
+		}
+		return nil
+	}
+
+	return visit([]string{roleName})
+}
+
+func circularRoleInheritanceError(cycle []string) error {
+	return fmt.Errorf("circular role inheritance: %s", strings.Join(cycle, " -> "))
+}
+
+func getCustomDBRole(conn *matlas.Client, projectID, roleName string) (*customDBRole, *matlas.Response, error) {
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, customDBRolePath(projectID, roleName), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(customDBRole)
+	resp, err := conn.Do(context.Background(), req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
+
+func listCustomDBRoles(conn *matlas.Client, projectID string) ([]customDBRole, error) {
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, fmt.Sprintf(customDBRolesPath, projectID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var roles []customDBRole
+	if _, err := conn.Do(context.Background(), req, &roles); err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+func customDBRolePath(projectID, roleName string) string {
+	return fmt.Sprintf(customDBRolesPath+"/%s", projectID, url.PathEscape(roleName))
+}
+
+func expandCustomDBRole(d *schema.ResourceData) *customDBRole {
+	role := &customDBRole{
+		Actions:        make([]customDBRoleAction, 0),
+		InheritedRoles: expandCustomDBInheritedRoles(d.Get("inherited_roles").(*schema.Set)),
+	}
+
+	for _, a := range d.Get("actions").([]interface{}) {
+		actionMap := a.(map[string]interface{})
+		action := customDBRoleAction{Action: actionMap["action"].(string)}
+
+		for _, r := range actionMap["resources"].([]interface{}) {
+			resourceMap := r.(map[string]interface{})
+			if cluster := resourceMap["cluster"].(bool); cluster {
+				action.Resources = append(action.Resources, customDBRoleResource{Cluster: &cluster})
+				continue
+			}
+			db := resourceMap["database_name"].(string)
+			collection := resourceMap["collection_name"].(string)
+			action.Resources = append(action.Resources, customDBRoleResource{DB: &db, Collection: &collection})
+		}
+
+		role.Actions = append(role.Actions, action)
+	}
+
+	return role
+}
+
+func expandCustomDBInheritedRoles(s *schema.Set) []customDBInheritedRole {
+	roles := make([]customDBInheritedRole, 0, s.Len())
+	for _, r := range s.List() {
+		roleMap := r.(map[string]interface{})
+		roles = append(roles, customDBInheritedRole{
+			Role: roleMap["role_name"].(string),
+			DB:   roleMap["database_name"].(string),
+		})
+	}
+	return roles
+}
+
+func flattenCustomDBRoleActions(actions []customDBRoleAction) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0, len(actions))
+	for _, action := range actions {
+		resources := make([]map[string]interface{}, 0, len(action.Resources))
+		for _, r := range action.Resources {
+			resource := map[string]interface{}{"cluster": r.Cluster != nil && *r.Cluster}
+			if r.DB != nil {
+				resource["database_name"] = *r.DB
+			}
+			if r.Collection != nil {
+				resource["collection_name"] = *r.Collection
+			}
+			resources = append(resources, resource)
+		}
+		results = append(results, map[string]interface{}{
+			"action":    action.Action,
+			"resources": resources,
+		})
+	}
+	return results
+}
+
+func flattenCustomDBInheritedRoles(roles []customDBInheritedRole) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0, len(roles))
+	for _, r := range roles {
+		results = append(results, map[string]interface{}{
+			"role_name":     r.Role,
+			"database_name": r.DB,
+		})
+	}
+	return results
+}
@@ -0,0 +1,177 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+func TestAccResourceMongoDBAtlasCustomDBRole_circularInheritance(t *testing.T) {
+	resourceName := "mongodbatlas_custom_db_role.parent"
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+	parentName := fmt.Sprintf("parent-%s", acctest.RandString(5))
+	childName := fmt.Sprintf("child-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBAtlasCustomDBRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasCustomDBRoleConfig(projectID, parentName, childName, "read"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasCustomDBRoleExists(resourceName),
+					testAccCheckMongoDBAtlasCustomDBRoleExists("mongodbatlas_custom_db_role.child"),
+					resource.TestCheckResourceAttr(resourceName, "project_id", projectID),
+					resource.TestCheckResourceAttr(resourceName, "role_name", parentName),
+					resource.TestCheckResourceAttr(resourceName, "actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "inherited_roles.#", "1"),
+				),
+			},
+			{
+				Config:      testAccMongoDBAtlasCustomDBRoleConfig(projectID, parentName, childName, childName),
+				ExpectError: regexp.MustCompile("circular role inheritance: " + parentName + " -> " + childName + " -> " + parentName),
+			},
+		},
+	})
+}
+
+func TestFindCustomDBRoleCycle(t *testing.T) {
+	inherited := map[string][]customDBInheritedRole{
+		"a": {{Role: "b", DB: "admin"}, {Role: "read", DB: "admin"}},
+		"b": {{Role: "c", DB: "admin"}},
+		"c": {{Role: "a", DB: "admin"}},
+		"d": {{Role: "b", DB: "admin"}},
+		"e": {{Role: "e", DB: "admin"}},
+		"f": {{Role: "f", DB: "sales"}},
+		"g": {{Role: "h", DB: "admin"}},
+		"h": {{Role: "i", DB: "admin"}},
+	}
+
+	cases := []struct {
+		role     string
+		expected []string
+	}{
+		{"a", []string{"a", "b", "c", "a"}},
+		{"c", []string{"c", "a", "b", "c"}},
+		{"e", []string{"e", "e"}},
+		// d reaches a cycle that doesn't include it.
+		{"d", nil},
+		// f inherits a role of another database, which isn't a custom role.
+		{"f", nil},
+		// i isn't a custom role of the project.
+		{"g", nil},
+	}
+	for _, c := range cases {
+		if cycle := findCustomDBRoleCycle(c.role, inherited); !reflect.DeepEqual(cycle, c.expected) {
+			t.Fatalf("%s: expected cycle %v, got %v", c.role, c.expected, cycle)
+		}
+	}
+}
+
+func TestValidateCustomDBRoleInheritance(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/groups/5d09d6a59ccf6445652a444a/customDBRoles/roles" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `[
+			{"roleName": "parent", "actions": [], "inheritedRoles": [{"role": "read", "db": "admin"}]},
+			{"roleName": "child", "actions": [], "inheritedRoles": [{"role": "parent", "db": "admin"}]}
+		]`)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Replaces the current inherited roles of parent.
+	if err := validateCustomDBRoleInheritance(client, "5d09d6a59ccf6445652a444a", "parent", []customDBInheritedRole{{Role: "readWrite", DB: "admin"}}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = validateCustomDBRoleInheritance(client, "5d09d6a59ccf6445652a444a", "parent", []customDBInheritedRole{{Role: "child", DB: "admin"}})
+	if err == nil || err.Error() != "circular role inheritance: parent -> child -> parent" {
+		t.Fatalf("expected circular role inheritance error, got %v", err)
+	}
+}
+
+func testAccCheckMongoDBAtlasCustomDBRoleExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*MongoDBClient).Atlas
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		ids := decodeStateID(rs.Primary.ID)
+		if _, _, err := getCustomDBRole(conn, ids["project_id"], ids["role_name"]); err != nil {
+			return fmt.Errorf("custom db role (%s) does not exist", ids["role_name"])
+		}
+		return nil
+	}
+}
+
+func testAccCheckMongoDBAtlasCustomDBRoleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*MongoDBClient).Atlas
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongodbatlas_custom_db_role" {
+			continue
+		}
+
+		ids := decodeStateID(rs.Primary.ID)
+		if _, _, err := getCustomDBRole(conn, ids["project_id"], ids["role_name"]); err == nil {
+			return fmt.Errorf("custom db role (%s) still exists", ids["role_name"])
+		}
+	}
+	return nil
+}
+
+func testAccMongoDBAtlasCustomDBRoleConfig(projectID, parentName, childName, parentInheritedRole string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_custom_db_role" "parent" {
+			project_id = "%[1]s"
+			role_name  = "%[2]s"
+
+			actions {
+				action = "FIND"
+				resources {
+					database_name   = "sales"
+					collection_name = "orders"
+				}
+			}
+
+			inherited_roles {
+				role_name     = "%[4]s"
+				database_name = "admin"
+			}
+		}
+
+		resource "mongodbatlas_custom_db_role" "child" {
+			project_id = "%[1]s"
+			role_name  = "%[3]s"
+
+			inherited_roles {
+				role_name     = "%[2]s"
+				database_name = "admin"
+			}
+
+			depends_on = [mongodbatlas_custom_db_role.parent]
+		}
+	`, projectID, parentName, childName, parentInheritedRole)
+}
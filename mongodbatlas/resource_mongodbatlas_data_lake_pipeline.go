@@ -0,0 +1,492 @@
+package mongodbatlas
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/spf13/cast"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+const (
+	dataLakePipelinesPath        = "../v2/groups/%s/pipelines"
+	errorDataLakePipelineCreate  = "error creating data lake pipeline (%s): %s"
+	errorDataLakePipelineRead    = "error getting data lake pipeline (%s): %s"
+	errorDataLakePipelineUpdate  = "error updating data lake pipeline (%s): %s"
+	errorDataLakePipelineDelete  = "error deleting data lake pipeline (%s): %s"
+	errorDataLakePipelineSetting = "error setting `%s` for data lake pipeline (%s): %s"
+)
+
+// dataLakePipeline represents the scheduled extraction of a collection of a cluster, from its
+// backup snapshots, into a data lake in cloud storage.
+// See more: https://www.mongodb.com/docs/atlas/reference/api-resources-spec/v2/#tag/Data-Lake-Pipelines
+type dataLakePipeline struct {
+	ID              string                             `json:"_id,omitempty"`
+	Name            string                             `json:"name,omitempty"`
+	Sink            *dataLakePipelineSink              `json:"sink,omitempty"`
+	Source          *dataLakePipelineSource            `json:"source,omitempty"`
+	Transformations *[]*dataLakePipelineTransformation `json:"transformations,omitempty"`
+	State           string                             `json:"state,omitempty"`
+	CreatedDate     string                             `json:"createdDate,omitempty"`
+	LastUpdatedDate string                             `json:"lastUpdatedDate,omitempty"`
+}
+
+type dataLakePipelineSink struct {
+	Type             string                            `json:"type,omitempty"`
+	MetadataProvider string                            `json:"metadataProvider,omitempty"`
+	MetadataRegion   string                            `json:"metadataRegion,omitempty"`
+	PartitionFields  []*dataLakePipelinePartitionField `json:"partitionFields,omitempty"`
+}
+
+type dataLakePipelinePartitionField struct {
+	FieldName string `json:"fieldName"`
+	Order     int    `json:"order"`
+}
+
+type dataLakePipelineSource struct {
+	Type           string `json:"type,omitempty"`
+	ClusterName    string `json:"clusterName,omitempty"`
+	DatabaseName   string `json:"databaseName,omitempty"`
+	CollectionName string `json:"collectionName,omitempty"`
+	PolicyItemID   string `json:"policyItemId,omitempty"`
+}
+
+type dataLakePipelineTransformation struct {
+	Field string `json:"field"`
+	Type  string `json:"type"`
+}
+
+func resourceMongoDBAtlasDataLakePipeline() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMongoDBAtlasDataLakePipelineCreate,
+		Read:   resourceMongoDBAtlasDataLakePipelineRead,
+		Update: resourceMongoDBAtlasDataLakePipelineUpdate,
+		Delete: resourceMongoDBAtlasDataLakePipelineDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMongoDBAtlasDataLakePipelineImportState,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"sink": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "DLS",
+							ValidateFunc: validation.StringInSlice([]string{"DLS"}, false),
+						},
+						"provider": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "AWS",
+							ValidateFunc: validation.StringInSlice([]string{"AWS"}, false),
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"partition_fields": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 2,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"field_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"order": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"source": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "PERIODIC_CPS",
+							ValidateFunc: validation.StringInSlice([]string{"ON_DEMAND_CPS", "PERIODIC_CPS"}, false),
+						},
+						"cluster_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"collection_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"policy_item_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"transformations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "EXCLUDE",
+							ValidateFunc: validation.StringInSlice([]string{"EXCLUDE"}, false),
+						},
+					},
+				},
+			},
+			"paused": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"pipeline_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMongoDBAtlasDataLakePipelineCreate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+	name := d.Get("name").(string)
+
+	pipeline := expandDataLakePipeline(d)
+	pipeline.Name = name
+	if _, err := doAtlasV2Request(conn, http.MethodPost, fmt.Sprintf(dataLakePipelinesPath, projectID), pipeline, nil); err != nil {
+		return fmt.Errorf(errorDataLakePipelineCreate, name, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id": projectID,
+		"name":       name,
+	}))
+
+	if err := waitForDataLakePipeline(conn, projectID, name, "ACTIVE", d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf(errorDataLakePipelineCreate, name, err)
+	}
+
+	if d.Get("paused").(bool) {
+		if err := setDataLakePipelinePaused(conn, projectID, name, true, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf(errorDataLakePipelineCreate, name, err)
+		}
+	}
+
+	return resourceMongoDBAtlasDataLakePipelineRead(d, meta)
+}
+
+func resourceMongoDBAtlasDataLakePipelineRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	name := ids["name"]
+
+	pipeline, resp, err := getDataLakePipeline(conn, ids["project_id"], name)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(errorDataLakePipelineRead, name, err)
+	}
+
+	if err := d.Set("sink", flattenDataLakePipelineSink(pipeline.Sink)); err != nil {
+		return fmt.Errorf(errorDataLakePipelineSetting, "sink", name, err)
+	}
+	if err := d.Set("source", flattenDataLakePipelineSource(pipeline.Source)); err != nil {
+		return fmt.Errorf(errorDataLakePipelineSetting, "source", name, err)
+	}
+	if err := d.Set("transformations", flattenDataLakePipelineTransformations(pipeline.Transformations)); err != nil {
+		return fmt.Errorf(errorDataLakePipelineSetting, "transformations", name, err)
+	}
+	if err := d.Set("paused", pipeline.State == "PAUSED"); err != nil {
+		return fmt.Errorf(errorDataLakePipelineSetting, "paused", name, err)
+	}
+	if err := d.Set("pipeline_id", pipeline.ID); err != nil {
+		return fmt.Errorf(errorDataLakePipelineSetting, "pipeline_id", name, err)
+	}
+	if err := d.Set("state", pipeline.State); err != nil {
+		return fmt.Errorf(errorDataLakePipelineSetting, "state", name, err)
+	}
+	if err := d.Set("created_date", pipeline.CreatedDate); err != nil {
+		return fmt.Errorf(errorDataLakePipelineSetting, "created_date", name, err)
+	}
+	if err := d.Set("last_updated_date", pipeline.LastUpdatedDate); err != nil {
+		return fmt.Errorf(errorDataLakePipelineSetting, "last_updated_date", name, err)
+	}
+
+	return nil
+}
+
+func resourceMongoDBAtlasDataLakePipelineUpdate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	projectID := ids["project_id"]
+	name := ids["name"]
+
+	if d.HasChange("sink") || d.HasChange("source") || d.HasChange("transformations") {
+		if _, err := doAtlasV2Request(conn, http.MethodPatch, dataLakePipelinePath(projectID, name), expandDataLakePipeline(d), nil); err != nil {
+			return fmt.Errorf(errorDataLakePipelineUpdate, name, err)
+		}
+	}
+
+	if d.HasChange("paused") {
+		if err := setDataLakePipelinePaused(conn, projectID, name, d.Get("paused").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf(errorDataLakePipelineUpdate, name, err)
+		}
+	}
+
+	return resourceMongoDBAtlasDataLakePipelineRead(d, meta)
+}
+
+func resourceMongoDBAtlasDataLakePipelineDelete(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	name := ids["name"]
+
+	if _, err := doAtlasV2Request(conn, http.MethodDelete, dataLakePipelinePath(ids["project_id"], name), nil, nil); err != nil {
+		return fmt.Errorf(errorDataLakePipelineDelete, name, err)
+	}
+	return nil
+}
+
+func resourceMongoDBAtlasDataLakePipelineImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*MongoDBClient).Atlas
+
+	parts := strings.SplitN(d.Id(), "-", 2)
+	if len(parts) != 2 {
+		return nil, errors.New("import format error: to import a data lake pipeline, use the format {project_id}-{name}")
+	}
+
+	projectID := parts[0]
+	name := parts[1]
+
+	if _, _, err := getDataLakePipeline(conn, projectID, name); err != nil {
+		return nil, fmt.Errorf("couldn't import data lake pipeline %s in project %s, error: %s", name, projectID, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id": projectID,
+		"name":       name,
+	}))
+
+	if err := d.Set("project_id", projectID); err != nil {
+		log.Printf("[WARN] Error setting project_id for (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("name", name); err != nil {
+		log.Printf("[WARN] Error setting name for (%s): %s", d.Id(), err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func dataLakePipelinePath(projectID, name string) string {
+	return fmt.Sprintf(dataLakePipelinesPath+"/%s", projectID, url.PathEscape(name))
+}
+
+func getDataLakePipeline(conn *matlas.Client, projectID, name string) (*dataLakePipeline, *matlas.Response, error) {
+	root := new(dataLakePipeline)
+	resp, err := doAtlasV2Request(conn, http.MethodGet, dataLakePipelinePath(projectID, name), nil, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
+
+// setDataLakePipelinePaused pauses or resumes the scheduled runs of the pipeline and waits until
+// Atlas reports the new state.
+func setDataLakePipelinePaused(conn *matlas.Client, projectID, name string, paused bool, timeout time.Duration) error {
+	action, target := "resume", "ACTIVE"
+	if paused {
+		action, target = "pause", "PAUSED"
+	}
+	if _, err := doAtlasV2Request(conn, http.MethodPost, dataLakePipelinePath(projectID, name)+"/"+action, nil, nil); err != nil {
+		return err
+	}
+	return waitForDataLakePipeline(conn, projectID, name, target, timeout)
+}
+
+func waitForDataLakePipeline(conn *matlas.Client, projectID, name, target string, timeout time.Duration) error {
+	//A new pipeline is ACTIVE once it's ready, pausing and resuming switch between ACTIVE and PAUSED.
+	pending := []string{"PENDING", "PAUSED"}
+	if target == "PAUSED" {
+		pending = []string{"PENDING", "ACTIVE"}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     []string{target},
+		Refresh:    resourceDataLakePipelineRefreshFunc(conn, projectID, name),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func resourceDataLakePipelineRefreshFunc(conn *matlas.Client, projectID, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		pipeline, _, err := getDataLakePipeline(conn, projectID, name)
+		if err != nil {
+			return nil, "", err
+		}
+		log.Printf("[DEBUG] status of the data lake pipeline (%s): %s", name, pipeline.State)
+		return pipeline, pipeline.State, nil
+	}
+}
+
+// expandDataLakePipeline never leaves the transformations nil, so that removing every
+// transformation from the configuration sends an empty list to Atlas instead of leaving them
+// unchanged.
+func expandDataLakePipeline(d *schema.ResourceData) *dataLakePipeline {
+	pipeline := &dataLakePipeline{}
+
+	if sinks := d.Get("sink").([]interface{}); len(sinks) > 0 && sinks[0] != nil {
+		sink := sinks[0].(map[string]interface{})
+		pipeline.Sink = &dataLakePipelineSink{
+			Type:             cast.ToString(sink["type"]),
+			MetadataProvider: cast.ToString(sink["provider"]),
+			MetadataRegion:   cast.ToString(sink["region"]),
+		}
+		for _, f := range sink["partition_fields"].([]interface{}) {
+			field := f.(map[string]interface{})
+			pipeline.Sink.PartitionFields = append(pipeline.Sink.PartitionFields, &dataLakePipelinePartitionField{
+				FieldName: cast.ToString(field["field_name"]),
+				Order:     cast.ToInt(field["order"]),
+			})
+		}
+	}
+
+	if sources := d.Get("source").([]interface{}); len(sources) > 0 && sources[0] != nil {
+		source := sources[0].(map[string]interface{})
+		pipeline.Source = &dataLakePipelineSource{
+			Type:           cast.ToString(source["type"]),
+			ClusterName:    cast.ToString(source["cluster_name"]),
+			DatabaseName:   cast.ToString(source["database_name"]),
+			CollectionName: cast.ToString(source["collection_name"]),
+			PolicyItemID:   cast.ToString(source["policy_item_id"]),
+		}
+	}
+
+	transformations := make([]*dataLakePipelineTransformation, 0)
+	for _, t := range d.Get("transformations").([]interface{}) {
+		transformation := t.(map[string]interface{})
+		transformations = append(transformations, &dataLakePipelineTransformation{
+			Field: cast.ToString(transformation["field"]),
+			Type:  cast.ToString(transformation["type"]),
+		})
+	}
+	pipeline.Transformations = &transformations
+
+	return pipeline
+}
+
+func flattenDataLakePipelineSink(sink *dataLakePipelineSink) []map[string]interface{} {
+	if sink == nil {
+		return nil
+	}
+	partitionFields := make([]map[string]interface{}, 0, len(sink.PartitionFields))
+	for _, field := range sink.PartitionFields {
+		partitionFields = append(partitionFields, map[string]interface{}{
+			"field_name": field.FieldName,
+			"order":      field.Order,
+		})
+	}
+	return []map[string]interface{}{{
+		"type":             sink.Type,
+		"provider":         sink.MetadataProvider,
+		"region":           sink.MetadataRegion,
+		"partition_fields": partitionFields,
+	}}
+}
+
+func flattenDataLakePipelineSource(source *dataLakePipelineSource) []map[string]interface{} {
+	if source == nil {
+		return nil
+	}
+	return []map[string]interface{}{{
+		"type":            source.Type,
+		"cluster_name":    source.ClusterName,
+		"database_name":   source.DatabaseName,
+		"collection_name": source.CollectionName,
+		"policy_item_id":  source.PolicyItemID,
+	}}
+}
+
+func flattenDataLakePipelineTransformations(transformations *[]*dataLakePipelineTransformation) []map[string]interface{} {
+	if transformations == nil {
+		return nil
+	}
+	results := make([]map[string]interface{}, 0, len(*transformations))
+	for _, transformation := range *transformations {
+		results = append(results, map[string]interface{}{
+			"field": transformation.Field,
+			"type":  transformation.Type,
+		})
+	}
+	return results
+}
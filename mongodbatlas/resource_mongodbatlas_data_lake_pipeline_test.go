@@ -0,0 +1,212 @@
+package mongodbatlas
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+func TestAccResourceMongoDBAtlasDataLakePipeline_basic(t *testing.T) {
+	resourceName := "mongodbatlas_data_lake_pipeline.test"
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+	clusterName := os.Getenv("MONGODB_ATLAS_CLUSTER_NAME")
+	name := fmt.Sprintf("test-acc-pipeline-%s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); checkClusterEnv(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBAtlasDataLakePipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasDataLakePipelineConfig(projectID, clusterName, name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "state", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "transformations.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "pipeline_id"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasDataLakePipelineConfig(projectID, clusterName, name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "paused", "true"),
+					resource.TestCheckResourceAttr(resourceName, "state", "PAUSED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     fmt.Sprintf("%s-%s", projectID, name),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMongoDBAtlasDataLakePipelineDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*MongoDBClient).Atlas
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongodbatlas_data_lake_pipeline" {
+			continue
+		}
+
+		ids := decodeStateID(rs.Primary.ID)
+		if _, _, err := getDataLakePipeline(conn, ids["project_id"], ids["name"]); err == nil {
+			return fmt.Errorf("data lake pipeline (%s) still exists", ids["name"])
+		}
+	}
+	return nil
+}
+
+func testAccMongoDBAtlasDataLakePipelineConfig(projectID, clusterName, name string, paused bool) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_data_lake_pipeline" "test" {
+			project_id = "%s"
+			name       = "%s"
+			paused     = %t
+
+			sink {
+				partition_fields {
+					field_name = "year"
+					order      = 0
+				}
+			}
+
+			source {
+				type            = "ON_DEMAND_CPS"
+				cluster_name    = "%s"
+				database_name   = "sample_airbnb"
+				collection_name = "listingsAndReviews"
+			}
+
+			transformations {
+				field = "host"
+			}
+		}
+	`, projectID, name, paused, clusterName)
+}
+
+func TestResourceMongoDBAtlasDataLakePipelineCreate(t *testing.T) {
+	var requests []string
+	var sent map[string]interface{}
+	state := "PENDING"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/atlas/v2/groups/5d09d6a59ccf6445652a444a/pipelines":
+			_ = json.NewDecoder(r.Body).Decode(&sent)
+		case r.Method == http.MethodPost:
+			state = "PAUSED"
+		case state == "PENDING":
+			state = "ACTIVE"
+		}
+		fmt.Fprintf(w, `{
+			"_id": "6580f6f2a9b9b1a2b3c4d5e6",
+			"name": "pipeline-test",
+			"sink": {"type": "DLS", "metadataProvider": "AWS", "metadataRegion": "US_EAST_1", "partitionFields": [{"fieldName": "year", "order": 0}]},
+			"source": {"type": "ON_DEMAND_CPS", "clusterName": "cluster0", "databaseName": "sample", "collectionName": "listings"},
+			"transformations": [{"field": "host", "type": "EXCLUDE"}],
+			"state": %q
+		}`, state)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/api/atlas/v1.0/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	d := resourceMongoDBAtlasDataLakePipeline().TestResourceData()
+	d.Set("project_id", "5d09d6a59ccf6445652a444a")
+	d.Set("name", "pipeline-test")
+	d.Set("sink", []interface{}{map[string]interface{}{
+		"type":             "DLS",
+		"provider":         "AWS",
+		"partition_fields": []interface{}{map[string]interface{}{"field_name": "year", "order": 0}},
+	}})
+	d.Set("source", []interface{}{map[string]interface{}{
+		"type":            "ON_DEMAND_CPS",
+		"cluster_name":    "cluster0",
+		"database_name":   "sample",
+		"collection_name": "listings",
+	}})
+	d.Set("transformations", []interface{}{map[string]interface{}{"field": "host", "type": "EXCLUDE"}})
+	d.Set("paused", true)
+
+	if err := resourceMongoDBAtlasDataLakePipelineCreate(d, &MongoDBClient{Atlas: client}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	path := "/api/atlas/v2/groups/5d09d6a59ccf6445652a444a/pipelines"
+	expected := []string{
+		"POST " + path,
+		"GET " + path + "/pipeline-test",
+		"POST " + path + "/pipeline-test/pause",
+		"GET " + path + "/pipeline-test",
+		"GET " + path + "/pipeline-test",
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Fatalf("expected requests %v, got %v", expected, requests)
+	}
+	if sent["name"] != "pipeline-test" || !reflect.DeepEqual(sent["transformations"], []interface{}{map[string]interface{}{"field": "host", "type": "EXCLUDE"}}) {
+		t.Fatalf("unexpected pipeline sent %v", sent)
+	}
+	if paused := d.Get("paused").(bool); !paused {
+		t.Fatal("expected the pipeline to be paused")
+	}
+	if region := d.Get("sink.0.region").(string); region != "US_EAST_1" {
+		t.Fatalf("expected the sink region chosen by Atlas to be read, got %s", region)
+	}
+}
+
+func TestResourceMongoDBAtlasDataLakePipelineRead_transformations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+			"_id": "6580f6f2a9b9b1a2b3c4d5e6",
+			"name": "pipeline-test",
+			"source": {"type": "PERIODIC_CPS", "clusterName": "cluster0", "databaseName": "sample", "collectionName": "listings"},
+			"transformations": [{"field": "host", "type": "EXCLUDE"}, {"field": "reviews", "type": "EXCLUDE"}],
+			"state": "ACTIVE"
+		}`)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/api/atlas/v1.0/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	d := resourceMongoDBAtlasDataLakePipeline().TestResourceData()
+	d.SetId(encodeStateID(map[string]string{
+		"project_id": "5d09d6a59ccf6445652a444a",
+		"name":       "pipeline-test",
+	}))
+	d.Set("transformations", []interface{}{map[string]interface{}{"field": "host", "type": "EXCLUDE"}})
+	d.Set("paused", true)
+
+	if err := resourceMongoDBAtlasDataLakePipelineRead(d, &MongoDBClient{Atlas: client}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{"field": "host", "type": "EXCLUDE"},
+		map[string]interface{}{"field": "reviews", "type": "EXCLUDE"},
+	}
+	if transformations := d.Get("transformations"); !reflect.DeepEqual(transformations, expected) {
+		t.Fatalf("expected the transformations added outside of Terraform to be read, got %v", transformations)
+	}
+	if paused := d.Get("paused").(bool); paused {
+		t.Fatal("expected the pipeline resumed outside of Terraform not to be paused")
+	}
+}
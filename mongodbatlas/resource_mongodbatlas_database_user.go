@@ -5,14 +5,40 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
+	"net/url"
+	"regexp"
 
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 
 	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
 )
 
+const (
+	databaseUsersPath = "groups/%s/databaseUsers"
+
+	//The authentication databases of SCRAM users and of users authenticated by AWS IAM or OIDC.
+	adminAuthDatabase    = "admin"
+	externalAuthDatabase = "$external"
+)
+
+var (
+	awsIAMARNRegex            = regexp.MustCompile(`^arn:aws[a-z-]*:iam::\d{12}:(user|role)/.+$`)
+	oidcIdentityRegex         = regexp.MustCompile(`^[^/\s]+/.+$`)
+	databaseUserAWSIAMTypes   = []string{"NONE", "USER", "ROLE"}
+	databaseUserOIDCAuthTypes = []string{"NONE", "IDP_GROUP", "USER"}
+)
+
+// databaseUser adds the authentication types that aren't supported by the client yet.
+type databaseUser struct {
+	matlas.DatabaseUser
+	AWSIAMType   string `json:"awsIAMType,omitempty"`
+	OIDCAuthType string `json:"oidcAuthType,omitempty"`
+}
+
 func resourceMongoDBAtlasDatabaseUser() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceMongoDBAtlasDatabaseUserCreate,
@@ -22,6 +48,7 @@ func resourceMongoDBAtlasDatabaseUser() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceMongoDBAtlasDatabaseUserImportState,
 		},
+		CustomizeDiff: resourceMongoDBAtlasDatabaseUserCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:     schema.TypeString,
@@ -42,6 +69,20 @@ func resourceMongoDBAtlasDatabaseUser() *schema.Resource {
 				Optional:  true,
 				Sensitive: true,
 			},
+			"aws_iam_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "NONE",
+				ValidateFunc: validation.StringInSlice(databaseUserAWSIAMTypes, false),
+			},
+			"oidc_auth_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "NONE",
+				ValidateFunc: validation.StringInSlice(databaseUserOIDCAuthTypes, false),
+			},
 			"roles": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -72,12 +113,12 @@ func resourceMongoDBAtlasDatabaseUser() *schema.Resource {
 
 func resourceMongoDBAtlasDatabaseUserRead(d *schema.ResourceData, meta interface{}) error {
 	//Get client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 	ids := decodeStateID(d.Id())
 	projectID := ids["project_id"]
 	username := ids["username"]
 
-	dbUser, _, err := conn.DatabaseUsers.Get(context.Background(), projectID, username)
+	dbUser, _, err := getDatabaseUser(conn, projectID, databaseUserAuthDatabase(d.Get("database_name").(string)), username)
 
 	if err != nil {
 		return fmt.Errorf("error getting database user information: %s", err)
@@ -91,32 +132,46 @@ func resourceMongoDBAtlasDatabaseUserRead(d *schema.ResourceData, meta interface
 	if err := d.Set("roles", flattenRoles(dbUser.Roles)); err != nil {
 		return fmt.Errorf("error setting `roles` for database user (%s): %s", d.Id(), err)
 	}
+	if err := d.Set("aws_iam_type", databaseUserAuthType(dbUser.AWSIAMType)); err != nil {
+		return fmt.Errorf("error setting `aws_iam_type` for database user (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("oidc_auth_type", databaseUserAuthType(dbUser.OIDCAuthType)); err != nil {
+		return fmt.Errorf("error setting `oidc_auth_type` for database user (%s): %s", d.Id(), err)
+	}
 
 	return nil
 }
 
 func resourceMongoDBAtlasDatabaseUserCreate(d *schema.ResourceData, meta interface{}) error {
 	//Get client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 	projectID := d.Get("project_id").(string)
 
-	dbUserReq := &matlas.DatabaseUser{
-		Roles:        expandRoles(d),
-		GroupID:      projectID,
-		Username:     d.Get("username").(string),
-		DatabaseName: d.Get("database_name").(string),
+	dbUserReq := &databaseUser{
+		DatabaseUser: matlas.DatabaseUser{
+			Roles:        expandRoles(d),
+			GroupID:      projectID,
+			Username:     d.Get("username").(string),
+			DatabaseName: d.Get("database_name").(string),
+		},
+		AWSIAMType:   d.Get("aws_iam_type").(string),
+		OIDCAuthType: d.Get("oidc_auth_type").(string),
 	}
 
 	if v, ok := d.GetOk("password"); ok {
 		dbUserReq.Password = v.(string)
 	}
 
-	dbUserRes, _, err := conn.DatabaseUsers.Create(context.Background(), projectID, dbUserReq)
-
+	req, err := conn.NewRequest(context.Background(), http.MethodPost, fmt.Sprintf(databaseUsersPath, projectID), dbUserReq)
 	if err != nil {
 		return fmt.Errorf("error creating database user: %s", err)
 	}
 
+	dbUserRes := new(databaseUser)
+	if _, err := conn.Do(context.Background(), req, dbUserRes); err != nil {
+		return fmt.Errorf("error creating database user: %s", err)
+	}
+
 	d.SetId(encodeStateID(map[string]string{
 		"project_id": projectID,
 		"username":   dbUserRes.Username,
@@ -127,12 +182,14 @@ func resourceMongoDBAtlasDatabaseUserCreate(d *schema.ResourceData, meta interfa
 
 func resourceMongoDBAtlasDatabaseUserUpdate(d *schema.ResourceData, meta interface{}) error {
 	//Get client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 	ids := decodeStateID(d.Id())
 	projectID := ids["project_id"]
 	username := ids["username"]
 
-	dbUser, _, err := conn.DatabaseUsers.Get(context.Background(), projectID, username)
+	authDatabase := databaseUserAuthDatabase(d.Get("database_name").(string))
+
+	dbUser, _, err := getDatabaseUser(conn, projectID, authDatabase, username)
 
 	if err != nil {
 		return fmt.Errorf("error getting database user information: %s", err)
@@ -145,8 +202,11 @@ func resourceMongoDBAtlasDatabaseUserUpdate(d *schema.ResourceData, meta interfa
 	if d.HasChange("roles") {
 		dbUser.Roles = expandRoles(d)
 	}
-	_, _, err = conn.DatabaseUsers.Update(context.Background(), projectID, username, dbUser)
 
+	req, err := conn.NewRequest(context.Background(), http.MethodPatch, databaseUserPath(projectID, authDatabase, username), dbUser)
+	if err == nil {
+		_, err = conn.Do(context.Background(), req, nil)
+	}
 	if err != nil {
 		return fmt.Errorf("error updating database user(%s): %s", username, err)
 	}
@@ -156,21 +216,79 @@ func resourceMongoDBAtlasDatabaseUserUpdate(d *schema.ResourceData, meta interfa
 
 func resourceMongoDBAtlasDatabaseUserDelete(d *schema.ResourceData, meta interface{}) error {
 	//Get client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 	ids := decodeStateID(d.Id())
 	projectID := ids["project_id"]
 	username := ids["username"]
 
-	_, err := conn.DatabaseUsers.Delete(context.Background(), projectID, username)
+	path := databaseUserPath(projectID, databaseUserAuthDatabase(d.Get("database_name").(string)), username)
 
+	req, err := conn.NewRequest(context.Background(), http.MethodDelete, path, nil)
+	if err == nil {
+		_, err = conn.Do(context.Background(), req, nil)
+	}
 	if err != nil {
 		return fmt.Errorf("error deleting database user (%s): %s", username, err)
 	}
 	return nil
 }
 
+func resourceMongoDBAtlasDatabaseUserCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	//The username may come from another resource, e.g. the ARN of an IAM role.
+	if !d.NewValueKnown("username") || !d.NewValueKnown("database_name") {
+		return nil
+	}
+	return validateDatabaseUserAuth(
+		d.Get("username").(string),
+		d.Get("database_name").(string),
+		d.Get("password").(string),
+		d.Get("aws_iam_type").(string),
+		d.Get("oidc_auth_type").(string),
+	)
+}
+
+// validateDatabaseUserAuth checks that the username, the authentication database and the password
+// match the authentication type of the user: an ARN for AWS IAM, an `{idp_id}/{name}` identity for
+// OIDC, both authenticated by the $external database (the admin database for OIDC groups) and
+// without password.
+func validateDatabaseUserAuth(username, databaseName, password, awsIAMType, oidcAuthType string) error {
+	awsIAM := awsIAMType != "" && awsIAMType != "NONE"
+	oidc := oidcAuthType != "" && oidcAuthType != "NONE"
+
+	switch {
+	case awsIAM && oidc:
+		return errors.New("only one of `aws_iam_type` and `oidc_auth_type` can be set")
+	case awsIAM:
+		m := awsIAMARNRegex.FindStringSubmatch(username)
+		if m == nil || !strings.EqualFold(m[1], awsIAMType) {
+			return fmt.Errorf("`username` must be the ARN of an AWS IAM %s, e.g. arn:aws:iam::123456789012:%s/name, got %s", strings.ToLower(awsIAMType), strings.ToLower(awsIAMType), username)
+		}
+		if databaseName != externalAuthDatabase {
+			return fmt.Errorf("`database_name` must be %s for AWS IAM users, got %s", externalAuthDatabase, databaseName)
+		}
+	case oidc:
+		if !oidcIdentityRegex.MatchString(username) {
+			return fmt.Errorf("`username` must be in the format {idp_id}/{name} for OIDC users, got %s", username)
+		}
+		expected := externalAuthDatabase
+		if oidcAuthType == "IDP_GROUP" {
+			expected = adminAuthDatabase
+		}
+		if databaseName != expected {
+			return fmt.Errorf("`database_name` must be %s for OIDC %s users, got %s", expected, oidcAuthType, databaseName)
+		}
+	default:
+		return nil
+	}
+
+	if password != "" {
+		return errors.New("`password` can't be set for users authenticated by AWS IAM or OIDC")
+	}
+	return nil
+}
+
 func resourceMongoDBAtlasDatabaseUserImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 
 	parts := strings.SplitN(d.Id(), "-", 2)
 	if len(parts) != 2 {
@@ -180,9 +298,13 @@ func resourceMongoDBAtlasDatabaseUserImportState(d *schema.ResourceData, meta in
 	projectID := parts[0]
 	username := parts[1]
 
-	u, _, err := conn.DatabaseUsers.Get(context.Background(), projectID, username)
+	//Users authenticated by AWS IAM or OIDC are only found in the $external database.
+	u, _, err := getDatabaseUser(conn, projectID, adminAuthDatabase, username)
 	if err != nil {
-		return nil, fmt.Errorf("couldn't import user %s in project %s, error: %s", username, projectID, err)
+		var errExternal error
+		if u, _, errExternal = getDatabaseUser(conn, projectID, externalAuthDatabase, username); errExternal != nil {
+			return nil, fmt.Errorf("couldn't import user %s in project %s, error: %s", username, projectID, err)
+		}
 	}
 
 	d.SetId(encodeStateID(map[string]string{
@@ -193,10 +315,49 @@ func resourceMongoDBAtlasDatabaseUserImportState(d *schema.ResourceData, meta in
 	if err := d.Set("project_id", u.GroupID); err != nil {
 		log.Printf("[WARN] Error setting project_id for (%s): %s", d.Id(), err)
 	}
+	if err := d.Set("database_name", u.DatabaseName); err != nil {
+		log.Printf("[WARN] Error setting database_name for (%s): %s", d.Id(), err)
+	}
 
 	return []*schema.ResourceData{d}, nil
 }
 
+func getDatabaseUser(conn *matlas.Client, projectID, authDatabase, username string) (*databaseUser, *matlas.Response, error) {
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, databaseUserPath(projectID, authDatabase, username), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(databaseUser)
+	resp, err := conn.Do(context.Background(), req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
+
+func databaseUserPath(projectID, authDatabase, username string) string {
+	return fmt.Sprintf(databaseUsersPath+"/%s/%s", projectID, url.PathEscape(authDatabase), url.PathEscape(username))
+}
+
+// databaseUserAuthDatabase returns the database that authenticates the user, SCRAM users are
+// always authenticated by the admin database.
+func databaseUserAuthDatabase(databaseName string) string {
+	if databaseName == externalAuthDatabase {
+		return externalAuthDatabase
+	}
+	return adminAuthDatabase
+}
+
+// databaseUserAuthType returns NONE for the users created before the authentication type was
+// returned by Atlas.
+func databaseUserAuthType(authType string) string {
+	if authType == "" {
+		return "NONE"
+	}
+	return authType
+}
+
 func expandRoles(d *schema.ResourceData) []matlas.Role {
 	var roles []matlas.Role
 	if v, ok := d.GetOk("roles"); ok {
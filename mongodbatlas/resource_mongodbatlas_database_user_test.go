@@ -1,7 +1,6 @@
 package mongodbatlas
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"os"
@@ -82,9 +81,78 @@ func TestAccResourceMongoDBAtlasDatabaseUser_importBasic(t *testing.T) {
 	})
 }
 
+func TestAccResourceMongoDBAtlasDatabaseUser_withAWSIAMType(t *testing.T) {
+	var dbUser matlas.DatabaseUser
+
+	resourceName := "mongodbatlas_database_user.test"
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+	username := fmt.Sprintf("arn:aws:iam::358363220050:role/test-acc-%s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBAtlasDatabaseUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasDatabaseUserWithAWSIAMTypeConfig(projectID, "atlasAdmin", username),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasDatabaseUserExists(resourceName, &dbUser),
+					testAccCheckMongoDBAtlasDatabaseUserAttributes(&dbUser, username),
+					resource.TestCheckResourceAttr(resourceName, "username", username),
+					resource.TestCheckResourceAttr(resourceName, "database_name", "$external"),
+					resource.TestCheckResourceAttr(resourceName, "aws_iam_type", "ROLE"),
+					resource.TestCheckResourceAttr(resourceName, "oidc_auth_type", "NONE"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasDatabaseUserWithAWSIAMTypeConfig(projectID, "read", username),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasDatabaseUserExists(resourceName, &dbUser),
+					resource.TestCheckResourceAttr(resourceName, "roles.0.role_name", "read"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateDatabaseUserAuth(t *testing.T) {
+	cases := []struct {
+		name         string
+		username     string
+		databaseName string
+		password     string
+		awsIAMType   string
+		oidcAuthType string
+		expectError  bool
+	}{
+		{name: "scram", username: "app", databaseName: "admin", password: "secret", awsIAMType: "NONE", oidcAuthType: "NONE"},
+		{name: "iam role", username: "arn:aws:iam::123456789012:role/app", databaseName: "$external", awsIAMType: "ROLE", oidcAuthType: "NONE"},
+		{name: "iam user", username: "arn:aws:iam::123456789012:user/app", databaseName: "$external", awsIAMType: "USER", oidcAuthType: "NONE"},
+		{name: "iam type mismatch", username: "arn:aws:iam::123456789012:user/app", databaseName: "$external", awsIAMType: "ROLE", oidcAuthType: "NONE", expectError: true},
+		{name: "iam not an arn", username: "app", databaseName: "$external", awsIAMType: "USER", oidcAuthType: "NONE", expectError: true},
+		{name: "iam admin database", username: "arn:aws:iam::123456789012:role/app", databaseName: "admin", awsIAMType: "ROLE", oidcAuthType: "NONE", expectError: true},
+		{name: "iam with password", username: "arn:aws:iam::123456789012:role/app", databaseName: "$external", password: "secret", awsIAMType: "ROLE", oidcAuthType: "NONE", expectError: true},
+		{name: "oidc group", username: "0oa1b2c3d4/atlas-admins", databaseName: "admin", awsIAMType: "NONE", oidcAuthType: "IDP_GROUP"},
+		{name: "oidc user", username: "0oa1b2c3d4/app", databaseName: "$external", awsIAMType: "NONE", oidcAuthType: "USER"},
+		{name: "oidc without idp", username: "atlas-admins", databaseName: "admin", awsIAMType: "NONE", oidcAuthType: "IDP_GROUP", expectError: true},
+		{name: "oidc group external database", username: "0oa1b2c3d4/atlas-admins", databaseName: "$external", awsIAMType: "NONE", oidcAuthType: "IDP_GROUP", expectError: true},
+		{name: "iam and oidc", username: "arn:aws:iam::123456789012:role/app", databaseName: "$external", awsIAMType: "ROLE", oidcAuthType: "USER", expectError: true},
+	}
+
+	for _, c := range cases {
+		err := validateDatabaseUserAuth(c.username, c.databaseName, c.password, c.awsIAMType, c.oidcAuthType)
+		if c.expectError && err == nil {
+			t.Fatalf("%s: expected error", c.name)
+		}
+		if !c.expectError && err != nil {
+			t.Fatalf("%s: unexpected error: %s", c.name, err)
+		}
+	}
+}
+
 func testAccCheckMongoDBAtlasDatabaseUserExists(resourceName string, dbUser *matlas.DatabaseUser) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*matlas.Client)
+		conn := testAccProvider.Meta().(*MongoDBClient).Atlas
 
 		rs, ok := s.RootModule().Resources[resourceName]
 		if !ok {
@@ -96,8 +164,9 @@ func testAccCheckMongoDBAtlasDatabaseUserExists(resourceName string, dbUser *mat
 
 		log.Printf("[DEBUG] projectID: %s", rs.Primary.Attributes["project_id"])
 
-		if dbUserResp, _, err := conn.DatabaseUsers.Get(context.Background(), rs.Primary.Attributes["project_id"], rs.Primary.Attributes["username"]); err == nil {
-			*dbUser = *dbUserResp
+		authDatabase := databaseUserAuthDatabase(rs.Primary.Attributes["database_name"])
+		if dbUserResp, _, err := getDatabaseUser(conn, rs.Primary.Attributes["project_id"], authDatabase, rs.Primary.Attributes["username"]); err == nil {
+			*dbUser = dbUserResp.DatabaseUser
 			return nil
 		}
 		return fmt.Errorf("database user(%s) does not exist", rs.Primary.Attributes["project_id"])
@@ -114,7 +183,7 @@ func testAccCheckMongoDBAtlasDatabaseUserAttributes(dbUser *matlas.DatabaseUser,
 }
 
 func testAccCheckMongoDBAtlasDatabaseUserDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*matlas.Client)
+	conn := testAccProvider.Meta().(*MongoDBClient).Atlas
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "mongodbatlas_database_user" {
@@ -122,7 +191,8 @@ func testAccCheckMongoDBAtlasDatabaseUserDestroy(s *terraform.State) error {
 		}
 
 		// Try to find the database user
-		_, _, err := conn.DatabaseUsers.Get(context.Background(), rs.Primary.Attributes["project_id"], rs.Primary.Attributes["username"])
+		authDatabase := databaseUserAuthDatabase(rs.Primary.Attributes["database_name"])
+		_, _, err := getDatabaseUser(conn, rs.Primary.Attributes["project_id"], authDatabase, rs.Primary.Attributes["username"])
 
 		if err == nil {
 			return fmt.Errorf("database user (%s) still exists", rs.Primary.Attributes["project_id"])
@@ -146,3 +216,19 @@ func testAccMongoDBAtlasDatabaseUserConfig(projectID, roleName, username string)
 		}
 	`, projectID, roleName, username)
 }
+
+func testAccMongoDBAtlasDatabaseUserWithAWSIAMTypeConfig(projectID, roleName, username string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_database_user" "test" {
+			username      = "%[3]s"
+			project_id    = "%[1]s"
+			database_name = "$external"
+			aws_iam_type  = "ROLE"
+
+			roles {
+				role_name     = "%[2]s"
+				database_name = "admin"
+			}
+		}
+	`, projectID, roleName, username)
+}
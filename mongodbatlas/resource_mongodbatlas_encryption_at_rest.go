@@ -141,7 +141,7 @@ func resourceMongoDBAtlasEncryptionAtRest() *schema.Resource {
 }
 
 func resourceMongoDBAtlasEncryptionAtRestCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 	awsRegion, _ := valRegion(d.Get("aws_kms.region"))
 
 	encryptionAtRestReq := &matlas.EncryptionAtRest{
@@ -181,7 +181,7 @@ func resourceMongoDBAtlasEncryptionAtRestCreate(d *schema.ResourceData, meta int
 }
 
 func resourceMongoDBAtlasEncryptionAtRestRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 
 	_, _, err := conn.EncryptionsAtRest.Get(context.Background(), d.Id())
 	if err != nil {
@@ -192,7 +192,7 @@ func resourceMongoDBAtlasEncryptionAtRestRead(d *schema.ResourceData, meta inter
 }
 
 func resourceMongoDBAtlasEncryptionAtRestDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 
 	_, err := conn.EncryptionsAtRest.Delete(context.Background(), d.Id())
 	if err != nil {
@@ -0,0 +1,247 @@
+package mongodbatlas
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+const (
+	federatedQueryLimitsPath = "../v2/groups/%s/dataFederation/%s/limits"
+
+	errorFederatedQueryLimitCreate  = "error setting federated query limit (%s) of tenant (%s): %s"
+	errorFederatedQueryLimitRead    = "error getting federated query limit (%s) of tenant (%s): %s"
+	errorFederatedQueryLimitDelete  = "error deleting federated query limit (%s) of tenant (%s): %s"
+	errorFederatedQueryLimitSetting = "error setting `%s` for federated query limit (%s) of tenant (%s): %s"
+)
+
+var (
+	// federatedQueryLimitNames are the limits on the bytes processed by a Data Federation instance.
+	federatedQueryLimitNames = []string{
+		"bytesProcessed.query",
+		"bytesProcessed.daily",
+		"bytesProcessed.weekly",
+		"bytesProcessed.monthly",
+	}
+	federatedQueryLimitOverrunPolicies = []string{"BLOCK", "BLOCK_AND_KILL"}
+)
+
+// federatedQueryLimit is a limit on the bytes processed by the queries of a Data Federation instance.
+// See more: https://www.mongodb.com/docs/atlas/reference/api-resources-spec/v2/#tag/Data-Federation/operation/createOneDataFederationQueryLimit
+type federatedQueryLimit struct {
+	Name             string `json:"name,omitempty"`
+	TenantName       string `json:"tenantName,omitempty"`
+	ProjectID        string `json:"projectId,omitempty"`
+	Value            int64  `json:"value"`
+	OverrunPolicy    string `json:"overrunPolicy,omitempty"`
+	CurrentUsage     int64  `json:"currentUsage,omitempty"`
+	DefaultLimit     int64  `json:"defaultLimit,omitempty"`
+	MaximumLimit     int64  `json:"maximumLimit,omitempty"`
+	LastModifiedDate string `json:"lastModifiedDate,omitempty"`
+}
+
+func resourceMongoDBAtlasFederatedQueryLimit() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMongoDBAtlasFederatedQueryLimitCreate,
+		Read:   resourceMongoDBAtlasFederatedQueryLimitRead,
+		Update: resourceMongoDBAtlasFederatedQueryLimitUpdate,
+		Delete: resourceMongoDBAtlasFederatedQueryLimitDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMongoDBAtlasFederatedQueryLimitImportState,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tenant_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"limit_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(federatedQueryLimitNames, false),
+			},
+			"value": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"overrun_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(federatedQueryLimitOverrunPolicies, false),
+			},
+			"current_usage": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"default_limit": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"maximum_limit": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_modified_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMongoDBAtlasFederatedQueryLimitCreate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+	tenantName := d.Get("tenant_name").(string)
+	limitName := d.Get("limit_name").(string)
+
+	if err := setFederatedQueryLimit(conn, projectID, tenantName, limitName, expandFederatedQueryLimit(d)); err != nil {
+		return fmt.Errorf(errorFederatedQueryLimitCreate, limitName, tenantName, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":  projectID,
+		"tenant_name": tenantName,
+		"limit_name":  limitName,
+	}))
+
+	return resourceMongoDBAtlasFederatedQueryLimitRead(d, meta)
+}
+
+func resourceMongoDBAtlasFederatedQueryLimitRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	tenantName := ids["tenant_name"]
+	limitName := ids["limit_name"]
+
+	limit := new(federatedQueryLimit)
+	resp, err := doAtlasV2Request(conn, http.MethodGet, federatedQueryLimitPath(ids["project_id"], tenantName, limitName), nil, limit)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(errorFederatedQueryLimitRead, limitName, tenantName, err)
+	}
+
+	//The limit may have been changed outside of Terraform, e.g. in the Atlas UI.
+	if err := d.Set("value", limit.Value); err != nil {
+		return fmt.Errorf(errorFederatedQueryLimitSetting, "value", limitName, tenantName, err)
+	}
+	if err := d.Set("overrun_policy", limit.OverrunPolicy); err != nil {
+		return fmt.Errorf(errorFederatedQueryLimitSetting, "overrun_policy", limitName, tenantName, err)
+	}
+	if err := d.Set("current_usage", limit.CurrentUsage); err != nil {
+		return fmt.Errorf(errorFederatedQueryLimitSetting, "current_usage", limitName, tenantName, err)
+	}
+	if err := d.Set("default_limit", limit.DefaultLimit); err != nil {
+		return fmt.Errorf(errorFederatedQueryLimitSetting, "default_limit", limitName, tenantName, err)
+	}
+	if err := d.Set("maximum_limit", limit.MaximumLimit); err != nil {
+		return fmt.Errorf(errorFederatedQueryLimitSetting, "maximum_limit", limitName, tenantName, err)
+	}
+	if err := d.Set("last_modified_date", limit.LastModifiedDate); err != nil {
+		return fmt.Errorf(errorFederatedQueryLimitSetting, "last_modified_date", limitName, tenantName, err)
+	}
+
+	return nil
+}
+
+func resourceMongoDBAtlasFederatedQueryLimitUpdate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	tenantName := ids["tenant_name"]
+	limitName := ids["limit_name"]
+
+	if err := setFederatedQueryLimit(conn, ids["project_id"], tenantName, limitName, expandFederatedQueryLimit(d)); err != nil {
+		return fmt.Errorf(errorFederatedQueryLimitCreate, limitName, tenantName, err)
+	}
+
+	return resourceMongoDBAtlasFederatedQueryLimitRead(d, meta)
+}
+
+func resourceMongoDBAtlasFederatedQueryLimitDelete(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	tenantName := ids["tenant_name"]
+	limitName := ids["limit_name"]
+
+	if _, err := doAtlasV2Request(conn, http.MethodDelete, federatedQueryLimitPath(ids["project_id"], tenantName, limitName), nil, nil); err != nil {
+		return fmt.Errorf(errorFederatedQueryLimitDelete, limitName, tenantName, err)
+	}
+	return nil
+}
+
+func resourceMongoDBAtlasFederatedQueryLimitImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*MongoDBClient).Atlas
+
+	//The tenant name may contain hyphens, the limit names don't.
+	parts := strings.SplitN(d.Id(), "-", 2)
+	if len(parts) != 2 || !strings.Contains(parts[1], "-") {
+		return nil, errors.New("import format error: to import a federated query limit, use the format {project_id}-{tenant_name}-{limit_name}")
+	}
+
+	projectID := parts[0]
+	i := strings.LastIndex(parts[1], "-")
+	tenantName := parts[1][:i]
+	limitName := parts[1][i+1:]
+
+	if _, err := doAtlasV2Request(conn, http.MethodGet, federatedQueryLimitPath(projectID, tenantName, limitName), nil, nil); err != nil {
+		return nil, fmt.Errorf("couldn't import federated query limit %s of tenant %s in project %s, error: %s", limitName, tenantName, projectID, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":  projectID,
+		"tenant_name": tenantName,
+		"limit_name":  limitName,
+	}))
+
+	if err := d.Set("project_id", projectID); err != nil {
+		log.Printf("[WARN] Error setting project_id for (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("tenant_name", tenantName); err != nil {
+		log.Printf("[WARN] Error setting tenant_name for (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("limit_name", limitName); err != nil {
+		log.Printf("[WARN] Error setting limit_name for (%s): %s", d.Id(), err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandFederatedQueryLimit(d *schema.ResourceData) *federatedQueryLimit {
+	return &federatedQueryLimit{
+		Value:         int64(d.Get("value").(int)),
+		OverrunPolicy: d.Get("overrun_policy").(string),
+	}
+}
+
+// setFederatedQueryLimit creates the limit, or replaces its value if it's already set.
+func setFederatedQueryLimit(conn *matlas.Client, projectID, tenantName, limitName string, limit *federatedQueryLimit) error {
+	_, err := doAtlasV2Request(conn, http.MethodPatch, federatedQueryLimitPath(projectID, tenantName, limitName), limit, nil)
+	return err
+}
+
+func federatedQueryLimitPath(projectID, tenantName, limitName string) string {
+	return fmt.Sprintf(federatedQueryLimitsPath+"/%s", projectID, url.PathEscape(tenantName), url.PathEscape(limitName))
+}
@@ -0,0 +1,187 @@
+package mongodbatlas
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+func TestAccResourceMongoDBAtlasFederatedQueryLimit_basic(t *testing.T) {
+	resourceName := "mongodbatlas_federated_query_limit.test"
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+	tenantName := os.Getenv("MONGODB_ATLAS_FEDERATED_TENANT_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBAtlasFederatedQueryLimitDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasFederatedQueryLimitConfig(projectID, tenantName, 5368709120, "BLOCK"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasFederatedQueryLimitExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "project_id", projectID),
+					resource.TestCheckResourceAttr(resourceName, "tenant_name", tenantName),
+					resource.TestCheckResourceAttr(resourceName, "limit_name", "bytesProcessed.daily"),
+					resource.TestCheckResourceAttr(resourceName, "value", "5368709120"),
+					resource.TestCheckResourceAttr(resourceName, "overrun_policy", "BLOCK"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasFederatedQueryLimitConfig(projectID, tenantName, 10737418240, "BLOCK_AND_KILL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasFederatedQueryLimitExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "10737418240"),
+					resource.TestCheckResourceAttr(resourceName, "overrun_policy", "BLOCK_AND_KILL"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     fmt.Sprintf("%s-%s-bytesProcessed.daily", projectID, tenantName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceMongoDBAtlasFederatedQueryLimitCreate(t *testing.T) {
+	var requests []string
+	var sent federatedQueryLimit
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.Header.Get("Accept") != atlasV2AcceptHeader {
+			t.Errorf("expected the versioned API to be requested, got %q", r.Header.Get("Accept"))
+		}
+		if r.Method == http.MethodPatch {
+			_ = json.NewDecoder(r.Body).Decode(&sent)
+		}
+		// Atlas rounds the value, which is reconciled on read.
+		fmt.Fprint(w, `{
+			"name": "bytesProcessed.daily",
+			"tenantName": "tenant-test",
+			"projectId": "5d09d6a59ccf6445652a444a",
+			"value": 5000000000,
+			"overrunPolicy": "BLOCK",
+			"currentUsage": 1024,
+			"defaultLimit": 0,
+			"maximumLimit": 10000000000000,
+			"lastModifiedDate": "2024-01-01T00:00:00Z"
+		}`)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/api/atlas/v1.0/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	d := resourceMongoDBAtlasFederatedQueryLimit().TestResourceData()
+	d.Set("project_id", "5d09d6a59ccf6445652a444a")
+	d.Set("tenant_name", "tenant-test")
+	d.Set("limit_name", "bytesProcessed.daily")
+	d.Set("value", 4999999999)
+	d.Set("overrun_policy", "BLOCK")
+
+	if err := resourceMongoDBAtlasFederatedQueryLimitCreate(d, &MongoDBClient{Atlas: client}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	path := "/api/atlas/v2/groups/5d09d6a59ccf6445652a444a/dataFederation/tenant-test/limits/bytesProcessed.daily"
+	if len(requests) != 2 || requests[0] != "PATCH "+path || requests[1] != "GET "+path {
+		t.Fatalf("unexpected requests %v", requests)
+	}
+	if sent.Value != 4999999999 || sent.OverrunPolicy != "BLOCK" {
+		t.Fatalf("unexpected limit sent %+v", sent)
+	}
+	if value := d.Get("value").(int); value != 5000000000 {
+		t.Fatalf("expected the value read from Atlas, got %d", value)
+	}
+	if usage := d.Get("current_usage").(int); usage != 1024 {
+		t.Fatalf("expected current usage 1024, got %d", usage)
+	}
+}
+
+func TestResourceMongoDBAtlasFederatedQueryLimitImportState(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/atlas/v2/groups/5d09d6a59ccf6445652a444a/dataFederation/tenant-with-hyphens/limits/bytesProcessed.query" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"name": "bytesProcessed.query", "value": 1000}`)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/api/atlas/v1.0/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	d := resourceMongoDBAtlasFederatedQueryLimit().TestResourceData()
+	d.SetId("5d09d6a59ccf6445652a444a-tenant-with-hyphens-bytesProcessed.query")
+
+	if _, err := resourceMongoDBAtlasFederatedQueryLimitImportState(d, &MongoDBClient{Atlas: client}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if tenantName := d.Get("tenant_name").(string); tenantName != "tenant-with-hyphens" {
+		t.Fatalf("expected tenant name tenant-with-hyphens, got %s", tenantName)
+	}
+	if limitName := d.Get("limit_name").(string); limitName != "bytesProcessed.query" {
+		t.Fatalf("expected limit name bytesProcessed.query, got %s", limitName)
+	}
+}
+
+func testAccCheckMongoDBAtlasFederatedQueryLimitExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*MongoDBClient).Atlas
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		ids := decodeStateID(rs.Primary.ID)
+		if _, err := doAtlasV2Request(conn, http.MethodGet, federatedQueryLimitPath(ids["project_id"], ids["tenant_name"], ids["limit_name"]), nil, nil); err != nil {
+			return fmt.Errorf("federated query limit (%s) does not exist", ids["limit_name"])
+		}
+		return nil
+	}
+}
+
+func testAccCheckMongoDBAtlasFederatedQueryLimitDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*MongoDBClient).Atlas
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongodbatlas_federated_query_limit" {
+			continue
+		}
+
+		ids := decodeStateID(rs.Primary.ID)
+		if _, err := doAtlasV2Request(conn, http.MethodGet, federatedQueryLimitPath(ids["project_id"], ids["tenant_name"], ids["limit_name"]), nil, nil); err == nil {
+			return fmt.Errorf("federated query limit (%s) still exists", ids["limit_name"])
+		}
+	}
+	return nil
+}
+
+func testAccMongoDBAtlasFederatedQueryLimitConfig(projectID, tenantName string, value int64, overrunPolicy string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_federated_query_limit" "test" {
+			project_id     = "%s"
+			tenant_name    = "%s"
+			limit_name     = "bytesProcessed.daily"
+			value          = %d
+			overrun_policy = "%s"
+		}
+	`, projectID, tenantName, value, overrunPolicy)
+}
@@ -0,0 +1,338 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/mwielbut/pointy"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+const (
+	ldapConfigurationPath          = "groups/%s/userSecurity"
+	ldapUserToDNMappingPath        = "groups/%s/userSecurity/ldap/userToDNMapping"
+	defaultLDAPConfigurationPort   = 636
+	errorLDAPConfigurationCreate   = "error setting LDAP configuration of project (%s): %s"
+	errorLDAPConfigurationRead     = "error getting LDAP configuration of project (%s): %s"
+	errorLDAPConfigurationDelete   = "error removing LDAP configuration of project (%s): %s"
+	errorLDAPConfigurationSetting  = "error setting `%s` for LDAP configuration of project (%s): %s"
+	errorLDAPUserToDNMappingFormat = "user_to_dn_mapping.%d: %s"
+)
+
+// ldapPlaceholderRegex matches the {0}, {1}... placeholders of a user to DN mapping, replaced by
+// the groups captured by its match.
+var ldapPlaceholderRegex = regexp.MustCompile(`\{(\d+)\}`)
+
+// ldapConfiguration is the LDAP configuration of a project, not supported by the client yet.
+// See more: https://docs.atlas.mongodb.com/reference/api/ldaps-configuration-save/
+type ldapConfiguration struct {
+	LDAP *ldapSettings `json:"ldap,omitempty"`
+}
+
+type ldapSettings struct {
+	AuthenticationEnabled *bool                  `json:"authenticationEnabled,omitempty"`
+	AuthorizationEnabled  *bool                  `json:"authorizationEnabled,omitempty"`
+	Hostname              string                 `json:"hostname,omitempty"`
+	Port                  int                    `json:"port,omitempty"`
+	BindUsername          string                 `json:"bindUsername,omitempty"`
+	BindPassword          string                 `json:"bindPassword,omitempty"`
+	CaCertificate         string                 `json:"caCertificate,omitempty"`
+	AuthzQueryTemplate    string                 `json:"authzQueryTemplate,omitempty"`
+	UserToDNMapping       []*ldapUserToDNMapping `json:"userToDNMapping,omitempty"`
+}
+
+type ldapUserToDNMapping struct {
+	Match        string `json:"match,omitempty"`
+	Substitution string `json:"substitution,omitempty"`
+	LDAPQuery    string `json:"ldapQuery,omitempty"`
+}
+
+func resourceMongoDBAtlasLDAPConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMongoDBAtlasLDAPConfigurationCreate,
+		Read:   resourceMongoDBAtlasLDAPConfigurationRead,
+		Update: resourceMongoDBAtlasLDAPConfigurationUpdate,
+		Delete: resourceMongoDBAtlasLDAPConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMongoDBAtlasLDAPConfigurationImportState,
+		},
+		CustomizeDiff: resourceMongoDBAtlasLDAPConfigurationCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"authentication_enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"authorization_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"hostname": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultLDAPConfigurationPort,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"bind_username": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"bind_password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"ca_certificate": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"authz_query_template": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"user_to_dn_mapping": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"match": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"substitution": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"ldap_query": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceMongoDBAtlasLDAPConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+
+	if err := saveLDAPConfiguration(conn, projectID, expandLDAPConfiguration(d)); err != nil {
+		return fmt.Errorf(errorLDAPConfigurationCreate, projectID, err)
+	}
+
+	d.SetId(projectID)
+
+	return resourceMongoDBAtlasLDAPConfigurationRead(d, meta)
+}
+
+func resourceMongoDBAtlasLDAPConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Id()
+
+	configuration := new(ldapConfiguration)
+	resp, err := doLDAPConfigurationRequest(conn, http.MethodGet, fmt.Sprintf(ldapConfigurationPath, projectID), nil, configuration)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(errorLDAPConfigurationRead, projectID, err)
+	}
+	if configuration.LDAP == nil {
+		d.SetId("")
+		return nil
+	}
+
+	//The bind password isn't returned by Atlas, the configured one is kept.
+	settings := configuration.LDAP
+	if err := d.Set("project_id", projectID); err != nil {
+		return fmt.Errorf(errorLDAPConfigurationSetting, "project_id", projectID, err)
+	}
+	if err := d.Set("authentication_enabled", pointy.BoolValue(settings.AuthenticationEnabled, false)); err != nil {
+		return fmt.Errorf(errorLDAPConfigurationSetting, "authentication_enabled", projectID, err)
+	}
+	if err := d.Set("authorization_enabled", pointy.BoolValue(settings.AuthorizationEnabled, false)); err != nil {
+		return fmt.Errorf(errorLDAPConfigurationSetting, "authorization_enabled", projectID, err)
+	}
+	if err := d.Set("hostname", settings.Hostname); err != nil {
+		return fmt.Errorf(errorLDAPConfigurationSetting, "hostname", projectID, err)
+	}
+	if err := d.Set("port", settings.Port); err != nil {
+		return fmt.Errorf(errorLDAPConfigurationSetting, "port", projectID, err)
+	}
+	if err := d.Set("bind_username", settings.BindUsername); err != nil {
+		return fmt.Errorf(errorLDAPConfigurationSetting, "bind_username", projectID, err)
+	}
+	if err := d.Set("ca_certificate", settings.CaCertificate); err != nil {
+		return fmt.Errorf(errorLDAPConfigurationSetting, "ca_certificate", projectID, err)
+	}
+	if err := d.Set("authz_query_template", settings.AuthzQueryTemplate); err != nil {
+		return fmt.Errorf(errorLDAPConfigurationSetting, "authz_query_template", projectID, err)
+	}
+	if err := d.Set("user_to_dn_mapping", flattenLDAPUserToDNMapping(settings.UserToDNMapping)); err != nil {
+		return fmt.Errorf(errorLDAPConfigurationSetting, "user_to_dn_mapping", projectID, err)
+	}
+
+	return nil
+}
+
+func resourceMongoDBAtlasLDAPConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Id()
+
+	if err := saveLDAPConfiguration(conn, projectID, expandLDAPConfiguration(d)); err != nil {
+		return fmt.Errorf(errorLDAPConfigurationCreate, projectID, err)
+	}
+
+	return resourceMongoDBAtlasLDAPConfigurationRead(d, meta)
+}
+
+func resourceMongoDBAtlasLDAPConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Id()
+
+	//Atlas keeps the LDAP configuration of a project, it's disabled and its mapping removed.
+	disabled := &ldapConfiguration{LDAP: &ldapSettings{
+		AuthenticationEnabled: pointy.Bool(false),
+		AuthorizationEnabled:  pointy.Bool(false),
+	}}
+	if err := saveLDAPConfiguration(conn, projectID, disabled); err != nil {
+		return fmt.Errorf(errorLDAPConfigurationDelete, projectID, err)
+	}
+	if _, err := doLDAPConfigurationRequest(conn, http.MethodDelete, fmt.Sprintf(ldapUserToDNMappingPath, projectID), nil, nil); err != nil {
+		return fmt.Errorf(errorLDAPConfigurationDelete, projectID, err)
+	}
+	return nil
+}
+
+func resourceMongoDBAtlasLDAPConfigurationImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Id()
+
+	configuration := new(ldapConfiguration)
+	if _, err := doLDAPConfigurationRequest(conn, http.MethodGet, fmt.Sprintf(ldapConfigurationPath, projectID), nil, configuration); err != nil {
+		return nil, fmt.Errorf("couldn't import LDAP configuration of project %s, error: %s", projectID, err)
+	}
+	if configuration.LDAP == nil {
+		return nil, fmt.Errorf("couldn't import LDAP configuration of project %s, error: LDAP isn't configured", projectID)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceMongoDBAtlasLDAPConfigurationCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("user_to_dn_mapping") {
+		return nil
+	}
+	return validateLDAPUserToDNMapping(d.Get("user_to_dn_mapping").([]interface{}))
+}
+
+// validateLDAPUserToDNMapping returns an error if a mapping would break the LDAP logins: its
+// match must be a valid regex and exactly one of substitution and ldap_query must be set, only
+// referencing the groups captured by the match.
+func validateLDAPUserToDNMapping(mappings []interface{}) error {
+	for i, m := range mappings {
+		mapping, ok := m.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		match, _ := mapping["match"].(string)
+		substitution, _ := mapping["substitution"].(string)
+		ldapQuery, _ := mapping["ldap_query"].(string)
+
+		matchRegex, err := regexp.Compile(match)
+		if err != nil {
+			return fmt.Errorf(errorLDAPUserToDNMappingFormat, i, fmt.Sprintf("match %q isn't a valid regex: %s", match, err))
+		}
+
+		if (substitution == "") == (ldapQuery == "") {
+			return fmt.Errorf(errorLDAPUserToDNMappingFormat, i, "exactly one of substitution or ldap_query must be set")
+		}
+
+		field, value := "substitution", substitution
+		if ldapQuery != "" {
+			field, value = "ldap_query", ldapQuery
+		}
+		for _, placeholder := range ldapPlaceholderRegex.FindAllStringSubmatch(value, -1) {
+			if group, _ := strconv.Atoi(placeholder[1]); group >= matchRegex.NumSubexp() {
+				return fmt.Errorf(errorLDAPUserToDNMappingFormat, i, fmt.Sprintf("%s references %s but match %q only captures %d groups", field, placeholder[0], match, matchRegex.NumSubexp()))
+			}
+		}
+	}
+	return nil
+}
+
+func expandLDAPConfiguration(d *schema.ResourceData) *ldapConfiguration {
+	settings := &ldapSettings{
+		AuthenticationEnabled: pointy.Bool(d.Get("authentication_enabled").(bool)),
+		AuthorizationEnabled:  pointy.Bool(d.Get("authorization_enabled").(bool)),
+		Hostname:              d.Get("hostname").(string),
+		Port:                  d.Get("port").(int),
+		BindUsername:          d.Get("bind_username").(string),
+		BindPassword:          d.Get("bind_password").(string),
+		CaCertificate:         d.Get("ca_certificate").(string),
+		AuthzQueryTemplate:    d.Get("authz_query_template").(string),
+	}
+
+	for _, m := range d.Get("user_to_dn_mapping").([]interface{}) {
+		mapping, ok := m.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		settings.UserToDNMapping = append(settings.UserToDNMapping, &ldapUserToDNMapping{
+			Match:        mapping["match"].(string),
+			Substitution: mapping["substitution"].(string),
+			LDAPQuery:    mapping["ldap_query"].(string),
+		})
+	}
+
+	return &ldapConfiguration{LDAP: settings}
+}
+
+func flattenLDAPUserToDNMapping(mappings []*ldapUserToDNMapping) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0, len(mappings))
+	for _, mapping := range mappings {
+		results = append(results, map[string]interface{}{
+			"match":        mapping.Match,
+			"substitution": mapping.Substitution,
+			"ldap_query":   mapping.LDAPQuery,
+		})
+	}
+	return results
+}
+
+func saveLDAPConfiguration(conn *matlas.Client, projectID string, configuration *ldapConfiguration) error {
+	_, err := doLDAPConfigurationRequest(conn, http.MethodPatch, fmt.Sprintf(ldapConfigurationPath, projectID), configuration, nil)
+	return err
+}
+
+func doLDAPConfigurationRequest(conn *matlas.Client, method, path string, body, v interface{}) (*matlas.Response, error) {
+	req, err := conn.NewRequest(context.Background(), method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	return conn.Do(context.Background(), req, v)
+}
@@ -0,0 +1,169 @@
+package mongodbatlas
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+func TestAccResourceMongoDBAtlasLDAPConfiguration_basic(t *testing.T) {
+	resourceName := "mongodbatlas_ldap_configuration.test"
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+	hostname := os.Getenv("MONGODB_ATLAS_LDAP_HOSTNAME")
+	username := os.Getenv("MONGODB_ATLAS_LDAP_USERNAME")
+	password := os.Getenv("MONGODB_ATLAS_LDAP_PASSWORD")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); checkLDAPEnv(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBAtlasLDAPConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasLDAPConfigurationConfig(projectID, hostname, username, password),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "project_id", projectID),
+					resource.TestCheckResourceAttr(resourceName, "authentication_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "hostname", hostname),
+					resource.TestCheckResourceAttr(resourceName, "user_to_dn_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "user_to_dn_mapping.0.match", "(.+)@example.com"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateId:           projectID,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bind_password"},
+			},
+		},
+	})
+}
+
+func TestValidateLDAPUserToDNMapping(t *testing.T) {
+	mapping := func(match, substitution, ldapQuery string) map[string]interface{} {
+		return map[string]interface{}{"match": match, "substitution": substitution, "ldap_query": ldapQuery}
+	}
+
+	cases := []struct {
+		mappings    []interface{}
+		expectError bool
+	}{
+		{mappings: nil, expectError: false},
+		{mappings: []interface{}{mapping("(.+)@example.com", "cn={0},ou=users,dc=example,dc=com", "")}, expectError: false},
+		{mappings: []interface{}{mapping("(.+)@(.+)", "", "ou=users,dc={1}??one?(uid={0})")}, expectError: false},
+		{mappings: []interface{}{mapping("(.+@example.com", "cn={0},dc=example,dc=com", "")}, expectError: true},
+		{mappings: []interface{}{mapping("(.+)@example.com", "", "")}, expectError: true},
+		{mappings: []interface{}{mapping("(.+)@example.com", "cn={0},dc=example,dc=com", "dc=example,dc=com??one?(uid={0})")}, expectError: true},
+		{mappings: []interface{}{mapping("(.+)@example.com", "cn={1},dc=example,dc=com", "")}, expectError: true},
+		{mappings: []interface{}{mapping("admin", "cn=admin,dc=example,dc=com", ""), mapping("[", "cn={0}", "")}, expectError: true},
+	}
+
+	for i, c := range cases {
+		err := validateLDAPUserToDNMapping(c.mappings)
+		if c.expectError && err == nil {
+			t.Fatalf("case %d: expected error", i)
+		}
+		if !c.expectError && err != nil {
+			t.Fatalf("case %d: unexpected error: %s", i, err)
+		}
+	}
+}
+
+func TestResourceMongoDBAtlasLDAPConfigurationCreate(t *testing.T) {
+	var requests []string
+	var sent ldapConfiguration
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodPatch {
+			_ = json.NewDecoder(r.Body).Decode(&sent)
+		}
+		fmt.Fprint(w, `{
+			"ldap": {
+				"authenticationEnabled": true,
+				"authorizationEnabled": false,
+				"hostname": "ldap.example.com",
+				"port": 636,
+				"bindUsername": "cn=admin,dc=example,dc=com",
+				"userToDNMapping": [{"match": "(.+)@example.com", "substitution": "cn={0},ou=users,dc=example,dc=com"}]
+			}
+		}`)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	d := resourceMongoDBAtlasLDAPConfiguration().TestResourceData()
+	d.Set("project_id", "5d09d6a59ccf6445652a444a")
+	d.Set("authentication_enabled", true)
+	d.Set("hostname", "ldap.example.com")
+	d.Set("port", 636)
+	d.Set("bind_username", "cn=admin,dc=example,dc=com")
+	d.Set("bind_password", "secret")
+	d.Set("user_to_dn_mapping", []interface{}{map[string]interface{}{"match": "(.+)@example.com", "substitution": "cn={0},ou=users,dc=example,dc=com"}})
+
+	if err := resourceMongoDBAtlasLDAPConfigurationCreate(d, &MongoDBClient{Atlas: client}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	path := "/groups/5d09d6a59ccf6445652a444a/userSecurity"
+	if len(requests) != 2 || requests[0] != "PATCH "+path || requests[1] != "GET "+path {
+		t.Fatalf("unexpected requests %v", requests)
+	}
+	if sent.LDAP == nil || sent.LDAP.BindPassword != "secret" || len(sent.LDAP.UserToDNMapping) != 1 || sent.LDAP.UserToDNMapping[0].LDAPQuery != "" {
+		t.Fatalf("unexpected configuration sent %+v", sent.LDAP)
+	}
+	if d.Id() != "5d09d6a59ccf6445652a444a" {
+		t.Fatalf("expected the project ID as ID, got %s", d.Id())
+	}
+	if password := d.Get("bind_password").(string); password != "secret" {
+		t.Fatalf("expected the configured bind password to be kept, got %q", password)
+	}
+}
+
+func testAccCheckMongoDBAtlasLDAPConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*MongoDBClient).Atlas
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongodbatlas_ldap_configuration" {
+			continue
+		}
+
+		configuration := new(ldapConfiguration)
+		if _, err := doLDAPConfigurationRequest(conn, http.MethodGet, fmt.Sprintf(ldapConfigurationPath, rs.Primary.ID), nil, configuration); err != nil {
+			return err
+		}
+		if configuration.LDAP != nil && configuration.LDAP.AuthenticationEnabled != nil && *configuration.LDAP.AuthenticationEnabled {
+			return fmt.Errorf("LDAP authentication of project (%s) is still enabled", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccMongoDBAtlasLDAPConfigurationConfig(projectID, hostname, username, password string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_ldap_configuration" "test" {
+			project_id             = "%s"
+			authentication_enabled = true
+			hostname               = "%s"
+			port                   = 636
+			bind_username          = "%s"
+			bind_password          = "%s"
+
+			user_to_dn_mapping {
+				match        = "(.+)@example.com"
+				substitution = "cn={0},ou=users,dc=example,dc=com"
+			}
+		}
+	`, projectID, hostname, username, password)
+}
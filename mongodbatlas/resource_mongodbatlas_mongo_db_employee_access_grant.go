@@ -0,0 +1,230 @@
+package mongodbatlas
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+const (
+	errorEmployeeAccessGrantCreate  = "error granting MongoDB employee access to cluster (%s): %s"
+	errorEmployeeAccessGrantRead    = "error getting MongoDB employee access grant of cluster (%s): %s"
+	errorEmployeeAccessGrantDelete  = "error revoking MongoDB employee access to cluster (%s): %s"
+	errorEmployeeAccessGrantSetting = "error setting `%s` for MongoDB employee access grant of cluster (%s): %s"
+)
+
+// employeeAccessGrantTypes are the levels of access that can be granted to MongoDB employees.
+var employeeAccessGrantTypes = []string{
+	"CLUSTER_DATABASE_LOGS",
+	"CLUSTER_INFRASTRUCTURE",
+	"CLUSTER_INFRASTRUCTURE_AND_APP_SERVICES_SYNC_DATA",
+}
+
+// employeeAccessGrant is the access granted to MongoDB employees on a cluster, only exposed by the
+// versioned API.
+// See more: https://www.mongodb.com/docs/atlas/reference/api-resources-spec/v2/#tag/Clusters/operation/grantMongoDbEmployeeAccess
+type employeeAccessGrant struct {
+	GrantType      string `json:"grantType,omitempty"`
+	ExpirationTime string `json:"expirationTime,omitempty"`
+}
+
+func resourceMongoDBAtlasMongoDBEmployeeAccessGrant() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMongoDBAtlasMongoDBEmployeeAccessGrantCreate,
+		Read:   resourceMongoDBAtlasMongoDBEmployeeAccessGrantRead,
+		Update: resourceMongoDBAtlasMongoDBEmployeeAccessGrantUpdate,
+		Delete: resourceMongoDBAtlasMongoDBEmployeeAccessGrantDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMongoDBAtlasMongoDBEmployeeAccessGrantImportState,
+		},
+		CustomizeDiff: resourceMongoDBAtlasMongoDBEmployeeAccessGrantCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"grant_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(employeeAccessGrantTypes, false),
+			},
+			"expiration_time": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: rfc3339DiffSuppressFunc,
+			},
+		},
+	}
+}
+
+func resourceMongoDBAtlasMongoDBEmployeeAccessGrantCreate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+	clusterName := d.Get("cluster_name").(string)
+
+	if err := grantEmployeeAccess(conn, projectID, clusterName, expandEmployeeAccessGrant(d)); err != nil {
+		return fmt.Errorf(errorEmployeeAccessGrantCreate, clusterName, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":   projectID,
+		"cluster_name": clusterName,
+	}))
+
+	return resourceMongoDBAtlasMongoDBEmployeeAccessGrantRead(d, meta)
+}
+
+func resourceMongoDBAtlasMongoDBEmployeeAccessGrantRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	clusterName := ids["cluster_name"]
+
+	grant, resp, err := getEmployeeAccessGrant(conn, ids["project_id"], clusterName)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(errorEmployeeAccessGrantRead, clusterName, err)
+	}
+
+	// Atlas drops the grant once it expires, or when it's revoked outside of Terraform.
+	if grant == nil {
+		log.Printf("[WARN] MongoDB employee access grant of cluster (%s) expired or was revoked, removing it from state", clusterName)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("grant_type", grant.GrantType); err != nil {
+		return fmt.Errorf(errorEmployeeAccessGrantSetting, "grant_type", clusterName, err)
+	}
+	if err := d.Set("expiration_time", grant.ExpirationTime); err != nil {
+		return fmt.Errorf(errorEmployeeAccessGrantSetting, "expiration_time", clusterName, err)
+	}
+
+	return nil
+}
+
+func resourceMongoDBAtlasMongoDBEmployeeAccessGrantUpdate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	clusterName := ids["cluster_name"]
+
+	// Granting access again replaces the current grant.
+	if err := grantEmployeeAccess(conn, ids["project_id"], clusterName, expandEmployeeAccessGrant(d)); err != nil {
+		return fmt.Errorf(errorEmployeeAccessGrantCreate, clusterName, err)
+	}
+
+	return resourceMongoDBAtlasMongoDBEmployeeAccessGrantRead(d, meta)
+}
+
+func resourceMongoDBAtlasMongoDBEmployeeAccessGrantDelete(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	clusterName := ids["cluster_name"]
+
+	path := fmt.Sprintf(clusterV2Path+":revokeMongoDBEmployeeAccess", ids["project_id"], url.PathEscape(clusterName))
+	if _, err := doAtlasV2Request(conn, http.MethodPost, path, nil, nil); err != nil {
+		return fmt.Errorf(errorEmployeeAccessGrantDelete, clusterName, err)
+	}
+	return nil
+}
+
+func resourceMongoDBAtlasMongoDBEmployeeAccessGrantImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*MongoDBClient).Atlas
+
+	parts := strings.SplitN(d.Id(), "-", 2)
+	if len(parts) != 2 {
+		return nil, errors.New("import format error: to import a MongoDB employee access grant, use the format {project_id}-{cluster_name}")
+	}
+
+	projectID := parts[0]
+	clusterName := parts[1]
+
+	grant, _, err := getEmployeeAccessGrant(conn, projectID, clusterName)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't import MongoDB employee access grant of cluster %s in project %s, error: %s", clusterName, projectID, err)
+	}
+	if grant == nil {
+		return nil, fmt.Errorf("couldn't import MongoDB employee access grant of cluster %s in project %s, error: MongoDB employees don't have access to the cluster", clusterName, projectID)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":   projectID,
+		"cluster_name": clusterName,
+	}))
+
+	if err := d.Set("project_id", projectID); err != nil {
+		log.Printf("[WARN] Error setting project_id for (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("cluster_name", clusterName); err != nil {
+		log.Printf("[WARN] Error setting cluster_name for (%s): %s", d.Id(), err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceMongoDBAtlasMongoDBEmployeeAccessGrantCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("expiration_time") || !d.NewValueKnown("expiration_time") {
+		return nil
+	}
+	return validateEmployeeAccessGrantExpirationTime(d.Get("expiration_time").(string), time.Now())
+}
+
+func expandEmployeeAccessGrant(d *schema.ResourceData) *employeeAccessGrant {
+	return &employeeAccessGrant{
+		GrantType:      d.Get("grant_type").(string),
+		ExpirationTime: d.Get("expiration_time").(string),
+	}
+}
+
+func grantEmployeeAccess(conn *matlas.Client, projectID, clusterName string, grant *employeeAccessGrant) error {
+	path := fmt.Sprintf(clusterV2Path+":grantMongoDBEmployeeAccess", projectID, url.PathEscape(clusterName))
+	_, err := doAtlasV2Request(conn, http.MethodPost, path, grant, nil)
+	return err
+}
+
+// getEmployeeAccessGrant returns the active grant of the cluster, or nil if MongoDB employees
+// don't have access to it.
+func getEmployeeAccessGrant(conn *matlas.Client, projectID, clusterName string) (*employeeAccessGrant, *matlas.Response, error) {
+	root := new(struct {
+		MongoDBEmployeeAccessGrant *employeeAccessGrant `json:"mongoDBEmployeeAccessGrant,omitempty"`
+	})
+	resp, err := doAtlasV2Request(conn, http.MethodGet, fmt.Sprintf(clusterV2Path, projectID, url.PathEscape(clusterName)), nil, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root.MongoDBEmployeeAccessGrant, resp, nil
+}
+
+// validateEmployeeAccessGrantExpirationTime returns an error unless the expiration time is an
+// RFC3339 timestamp in the future.
+func validateEmployeeAccessGrantExpirationTime(expirationTime string, now time.Time) error {
+	date, err := time.Parse(time.RFC3339, expirationTime)
+	if err != nil {
+		return fmt.Errorf("expiration_time must be an RFC3339 timestamp, e.g. 2030-01-01T00:00:00Z: %s", err)
+	}
+	if !date.After(now) {
+		return fmt.Errorf("expiration_time (%s) must be in the future", expirationTime)
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package mongodbatlas
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+func TestAccResourceMongoDBAtlasMongoDBEmployeeAccessGrant_basic(t *testing.T) {
+	resourceName := "mongodbatlas_mongo_db_employee_access_grant.test"
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+	clusterName := os.Getenv("MONGODB_ATLAS_CLUSTER_NAME")
+	expirationTime := time.Now().Add(48 * time.Hour).UTC().Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBAtlasMongoDBEmployeeAccessGrantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasMongoDBEmployeeAccessGrantConfig(projectID, clusterName, "CLUSTER_DATABASE_LOGS", expirationTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasMongoDBEmployeeAccessGrantExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "project_id", projectID),
+					resource.TestCheckResourceAttr(resourceName, "cluster_name", clusterName),
+					resource.TestCheckResourceAttr(resourceName, "grant_type", "CLUSTER_DATABASE_LOGS"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasMongoDBEmployeeAccessGrantConfig(projectID, clusterName, "CLUSTER_INFRASTRUCTURE", expirationTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasMongoDBEmployeeAccessGrantExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "grant_type", "CLUSTER_INFRASTRUCTURE"),
+				),
+			},
+		},
+	})
+}
+
+func TestGrantEmployeeAccess(t *testing.T) {
+	var requests []string
+	var granted employeeAccessGrant
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.Header.Get("Accept") != atlasV2AcceptHeader {
+			t.Errorf("expected the versioned API to be requested, got %q", r.Header.Get("Accept"))
+		}
+		if r.Method == http.MethodPost {
+			_ = json.NewDecoder(r.Body).Decode(&granted)
+			fmt.Fprint(w, `{}`)
+			return
+		}
+		fmt.Fprint(w, `{"name": "cluster0", "mongoDBEmployeeAccessGrant": {"grantType": "CLUSTER_DATABASE_LOGS", "expirationTime": "2030-01-01T00:00:00Z"}}`)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/api/atlas/v1.0/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	grant := &employeeAccessGrant{GrantType: "CLUSTER_DATABASE_LOGS", ExpirationTime: "2030-01-01T00:00:00Z"}
+	if err := grantEmployeeAccess(client, "5d09d6a59ccf6445652a444a", "cluster0", grant); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if granted != *grant {
+		t.Fatalf("expected %+v to be granted, got %+v", *grant, granted)
+	}
+
+	current, _, err := getEmployeeAccessGrant(client, "5d09d6a59ccf6445652a444a", "cluster0")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if current == nil || *current != *grant {
+		t.Fatalf("expected the active grant %+v, got %+v", *grant, current)
+	}
+
+	expected := []string{
+		"POST /api/atlas/v2/groups/5d09d6a59ccf6445652a444a/clusters/cluster0:grantMongoDBEmployeeAccess",
+		"GET /api/atlas/v2/groups/5d09d6a59ccf6445652a444a/clusters/cluster0",
+	}
+	if fmt.Sprint(requests) != fmt.Sprint(expected) {
+		t.Fatalf("expected requests %v, got %v", expected, requests)
+	}
+}
+
+func TestValidateEmployeeAccessGrantExpirationTime(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		expirationTime string
+		expectError    bool
+	}{
+		{expirationTime: "2024-01-02T00:00:00Z", expectError: false},
+		{expirationTime: "2024-01-01T12:00:00+02:00", expectError: false},
+		{expirationTime: "2024-01-01T00:00:00Z", expectError: true},
+		{expirationTime: "2023-12-31T00:00:00Z", expectError: true},
+		{expirationTime: "2024-01-02", expectError: true},
+	}
+
+	for _, c := range cases {
+		err := validateEmployeeAccessGrantExpirationTime(c.expirationTime, now)
+		if c.expectError && err == nil {
+			t.Fatalf("expected error for %q", c.expirationTime)
+		}
+		if !c.expectError && err != nil {
+			t.Fatalf("unexpected error for %q: %s", c.expirationTime, err)
+		}
+	}
+}
+
+func testAccCheckMongoDBAtlasMongoDBEmployeeAccessGrantExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*MongoDBClient).Atlas
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		ids := decodeStateID(rs.Primary.ID)
+		grant, _, err := getEmployeeAccessGrant(conn, ids["project_id"], ids["cluster_name"])
+		if err != nil || grant == nil {
+			return fmt.Errorf("MongoDB employee access grant of cluster (%s) does not exist", ids["cluster_name"])
+		}
+		return nil
+	}
+}
+
+func testAccCheckMongoDBAtlasMongoDBEmployeeAccessGrantDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*MongoDBClient).Atlas
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongodbatlas_mongo_db_employee_access_grant" {
+			continue
+		}
+
+		ids := decodeStateID(rs.Primary.ID)
+		if grant, _, err := getEmployeeAccessGrant(conn, ids["project_id"], ids["cluster_name"]); err == nil && grant != nil {
+			return fmt.Errorf("MongoDB employee access grant of cluster (%s) still exists", ids["cluster_name"])
+		}
+	}
+	return nil
+}
+
+func testAccMongoDBAtlasMongoDBEmployeeAccessGrantConfig(projectID, clusterName, grantType, expirationTime string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_mongo_db_employee_access_grant" "test" {
+			project_id      = "%s"
+			cluster_name    = "%s"
+			grant_type      = "%s"
+			expiration_time = "%s"
+		}
+	`, projectID, clusterName, grantType, expirationTime)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"reflect"
 	"strings"
@@ -66,9 +67,10 @@ func resourceMongoDBAtlasNetworkPeering() *schema.Resource {
 				Default:      "AWS",
 			},
 			"route_table_cidr_block": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.CIDRNetwork(0, 32),
 			},
 			"vpc_id": {
 				Type:     schema.TypeString,
@@ -91,6 +93,10 @@ func resourceMongoDBAtlasNetworkPeering() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"accepter_required": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 
 			"atlas_cidr_block": {
 				Type:     schema.TypeString,
@@ -145,7 +151,7 @@ func resourceMongoDBAtlasNetworkPeering() *schema.Resource {
 
 func resourceMongoDBAtlasNetworkPeeringCreate(d *schema.ResourceData, meta interface{}) error {
 	//Get client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 	projectID := d.Get("project_id").(string)
 	providerName := d.Get("provider_name").(string)
 
@@ -176,6 +182,10 @@ func resourceMongoDBAtlasNetworkPeeringCreate(d *schema.ResourceData, meta inter
 			return errors.New("`vpc_id` must be set when `provider_name` is `AWS`")
 		}
 
+		if err := validatePeeringRouteTableCIDRBlock(conn, projectID, peerRequest.ContainerID, rtCIDR.(string)); err != nil {
+			return fmt.Errorf(errorPeersCreate, err)
+		}
+
 		peerRequest.AccepterRegionName = region
 		peerRequest.AWSAccountId = awsAccountID.(string)
 		peerRequest.RouteTableCIDRBlock = rtCIDR.(string)
@@ -262,7 +272,7 @@ func resourceMongoDBAtlasNetworkPeeringCreate(d *schema.ResourceData, meta inter
 
 func resourceMongoDBAtlasNetworkPeeringRead(d *schema.ResourceData, meta interface{}) error {
 	//Get client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 	ids := decodeStateID(d.Id())
 	projectID := ids["project_id"]
 	peerID := ids["peer_id"]
@@ -305,6 +315,9 @@ func resourceMongoDBAtlasNetworkPeeringRead(d *schema.ResourceData, meta interfa
 	if err := d.Set("status_name", peer.StatusName); err != nil {
 		return fmt.Errorf("error setting `status_name` for Network Peering Connection (%s): %s", peerID, err)
 	}
+	if err := d.Set("accepter_required", networkPeeringAccepterRequired(peer)); err != nil {
+		return fmt.Errorf("error setting `accepter_required` for Network Peering Connection (%s): %s", peerID, err)
+	}
 	if err := d.Set("atlas_cidr_block", peer.AtlasCIDRBlock); err != nil {
 		return fmt.Errorf("error setting `atlas_cidr_block` for Network Peering Connection (%s): %s", peerID, err)
 	}
@@ -343,7 +356,7 @@ func resourceMongoDBAtlasNetworkPeeringRead(d *schema.ResourceData, meta interfa
 
 func resourceMongoDBAtlasNetworkPeeringUpdate(d *schema.ResourceData, meta interface{}) error {
 	//Get client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 	ids := decodeStateID(d.Id())
 	projectID := ids["project_id"]
 	peerID := ids["peer_id"]
@@ -365,6 +378,10 @@ func resourceMongoDBAtlasNetworkPeeringUpdate(d *schema.ResourceData, meta inter
 
 	if d.HasChange("route_table_cidr_block") {
 		peer.RouteTableCIDRBlock = d.Get("route_table_cidr_block").(string)
+
+		if err := validatePeeringRouteTableCIDRBlock(conn, projectID, d.Get("container_id").(string), peer.RouteTableCIDRBlock); err != nil {
+			return fmt.Errorf(errorPeersUpdate, peerID, err)
+		}
 	}
 
 	if d.HasChange("vpc_id") {
@@ -427,7 +444,7 @@ func resourceMongoDBAtlasNetworkPeeringUpdate(d *schema.ResourceData, meta inter
 
 func resourceMongoDBAtlasNetworkPeeringDelete(d *schema.ResourceData, meta interface{}) error {
 	//Get client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 	ids := decodeStateID(d.Id())
 	projectID := ids["project_id"]
 	peerID := ids["peer_id"]
@@ -458,7 +475,7 @@ func resourceMongoDBAtlasNetworkPeeringDelete(d *schema.ResourceData, meta inter
 }
 
 func resourceMongoDBAtlasNetworkPeeringImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 
 	parts := strings.SplitN(d.Id(), "-", 2)
 	if len(parts) != 2 {
@@ -501,6 +518,65 @@ func resourceMongoDBAtlasNetworkPeeringImportState(d *schema.ResourceData, meta
 	return []*schema.ResourceData{d}, nil
 }
 
+// validatePeeringRouteTableCIDRBlock returns an error if the route table CIDR block of an AWS
+// peering overlaps the CIDR block of the Atlas container, Atlas accepts the peering but the
+// traffic is never routed.
+func validatePeeringRouteTableCIDRBlock(conn *matlas.Client, projectID, containerID, routeTableCIDRBlock string) error {
+	container, _, err := conn.Containers.Get(context.Background(), projectID, containerID)
+	if err != nil {
+		return fmt.Errorf("error getting container (%s) to validate `route_table_cidr_block`: %s", containerID, err)
+	}
+	if container.AtlasCIDRBlock == "" {
+		return nil
+	}
+
+	overlap, err := cidrBlocksOverlap(routeTableCIDRBlock, container.AtlasCIDRBlock)
+	if err != nil {
+		return err
+	}
+	if overlap {
+		return fmt.Errorf("`route_table_cidr_block` (%s) overlaps the `atlas_cidr_block` (%s) of container (%s), use a CIDR block of the peered VPC that doesn't overlap it", routeTableCIDRBlock, container.AtlasCIDRBlock, containerID)
+	}
+	return nil
+}
+
+// cidrBlocksOverlap returns whether two CIDR blocks share any address, i.e. whether one of them
+// contains the network address of the other.
+func cidrBlocksOverlap(a, b string) (bool, error) {
+	_, netA, err := net.ParseCIDR(a)
+	if err != nil {
+		return false, fmt.Errorf("invalid CIDR block %q: %s", a, err)
+	}
+	_, netB, err := net.ParseCIDR(b)
+	if err != nil {
+		return false, fmt.Errorf("invalid CIDR block %q: %s", b, err)
+	}
+	return netA.Contains(netB.IP) || netB.Contains(netA.IP), nil
+}
+
+// networkPeeringAvailableTimeout is how long a peering may wait for its acceptance on the AWS side.
+const networkPeeringAvailableTimeout = 1 * time.Hour
+
+// networkPeeringAccepterRequired returns whether the AWS peering connection is waiting to be
+// accepted in the AWS account of the peer VPC, e.g. by an aws_vpc_peering_connection_accepter.
+func networkPeeringAccepterRequired(peer *matlas.Peer) bool {
+	return peer.ConnectionID != "" && peer.StatusName == "PENDING_ACCEPTANCE"
+}
+
+// waitForNetworkPeeringAvailable waits for the peering to become AVAILABLE, which only happens
+// once it's accepted on the AWS side.
+func waitForNetworkPeeringAvailable(conn *matlas.Client, projectID, peerID string, timeout, pollInterval time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"INITIATING", "FINALIZING", "ADDING_PEER", "WAITING_FOR_USER", "PENDING_ACCEPTANCE"},
+		Target:       []string{"AVAILABLE"},
+		Refresh:      resourceNetworkPeeringRefreshFunc(peerID, projectID, conn),
+		Timeout:      timeout,
+		PollInterval: pollInterval,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
+
 func resourceNetworkPeeringRefreshFunc(peerID, projectID string, client *matlas.Client) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		c, resp, err := client.Peers.Get(context.Background(), projectID, peerID)
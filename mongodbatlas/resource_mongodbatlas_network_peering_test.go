@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -52,6 +55,96 @@ func TestAccResourceMongoDBAtlasNetworkPeering_basic(t *testing.T) {
 
 }
 
+func TestCIDRBlocksOverlap(t *testing.T) {
+	cases := []struct {
+		a, b    string
+		overlap bool
+	}{
+		{a: "10.0.0.0/24", b: "192.168.248.0/21", overlap: false},
+		{a: "192.168.248.0/24", b: "192.168.248.0/21", overlap: true},
+		{a: "192.168.0.0/16", b: "192.168.248.0/21", overlap: true},
+		{a: "192.168.240.0/21", b: "192.168.248.0/21", overlap: false},
+	}
+
+	for _, c := range cases {
+		overlap, err := cidrBlocksOverlap(c.a, c.b)
+		if err != nil {
+			t.Fatalf("unexpected error for %s and %s: %s", c.a, c.b, err)
+		}
+		if overlap != c.overlap {
+			t.Fatalf("expected overlap of %s and %s to be %t", c.a, c.b, c.overlap)
+		}
+	}
+
+	if _, err := cidrBlocksOverlap("10.0.0.0", "192.168.248.0/21"); err == nil {
+		t.Fatal("expected an error for an invalid CIDR block")
+	}
+}
+
+func TestValidatePeeringRouteTableCIDRBlock(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/groups/5d09d6a59ccf6445652a444a/containers/5d1285acd5ec13b6c2d1726a" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"id": "5d1285acd5ec13b6c2d1726a", "providerName": "AWS", "atlasCidrBlock": "192.168.248.0/21", "regionName": "US_EAST_1"}`)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if err := validatePeeringRouteTableCIDRBlock(client, "5d09d6a59ccf6445652a444a", "5d1285acd5ec13b6c2d1726a", "172.31.0.0/16"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := validatePeeringRouteTableCIDRBlock(client, "5d09d6a59ccf6445652a444a", "5d1285acd5ec13b6c2d1726a", "192.168.0.0/16"); err == nil {
+		t.Fatal("expected an error for a CIDR block overlapping the container")
+	}
+}
+
+func TestWaitForNetworkPeeringAvailable(t *testing.T) {
+	statuses := []string{"PENDING_ACCEPTANCE", "PENDING_ACCEPTANCE", "FINALIZING", "AVAILABLE"}
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/groups/5d09d6a59ccf6445652a444a/peers/5d1285acd5ec13b6c2d1726b" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		status := statuses[len(statuses)-1]
+		if requests < len(statuses) {
+			status = statuses[requests]
+		}
+		requests++
+		fmt.Fprintf(w, `{"id": "5d1285acd5ec13b6c2d1726b", "connectionId": "pcx-0123456789abcdef0", "statusName": %q}`, status)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	peer, _, err := client.Peers.Get(context.Background(), "5d09d6a59ccf6445652a444a", "5d1285acd5ec13b6c2d1726b")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !networkPeeringAccepterRequired(peer) {
+		t.Fatal("expected the peering pending acceptance to require an accepter")
+	}
+
+	if err := waitForNetworkPeeringAvailable(client, "5d09d6a59ccf6445652a444a", "5d1285acd5ec13b6c2d1726b", time.Minute, time.Millisecond); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if requests != len(statuses) {
+		t.Fatalf("expected %d requests, got %d", len(statuses), requests)
+	}
+
+	if networkPeeringAccepterRequired(&matlas.Peer{ConnectionID: "pcx-0123456789abcdef0", StatusName: "AVAILABLE"}) {
+		t.Fatal("expected the available peering not to require an accepter")
+	}
+}
+
 func testAccCheckMongoDBAtlasNetworkPeeringImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -64,7 +157,7 @@ func testAccCheckMongoDBAtlasNetworkPeeringImportStateIDFunc(resourceName string
 
 func testAccCheckMongoDBAtlasNetworkPeeringExists(resourceName string, peer *matlas.Peer) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*matlas.Client)
+		conn := testAccProvider.Meta().(*MongoDBClient).Atlas
 
 		rs, ok := s.RootModule().Resources[resourceName]
 		if !ok {
@@ -96,7 +189,7 @@ func testAccCheckMongoDBAtlasNetworkPeeringAttributes(peer *matlas.Peer, vpcCIDR
 }
 
 func testAccCheckMongoDBAtlasNetworkPeeringDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*matlas.Client)
+	conn := testAccProvider.Meta().(*MongoDBClient).Atlas
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "mongodbatlas_network_peering" {
@@ -3,8 +3,16 @@ package mongodbatlas
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/mwielbut/pointy"
+	"github.com/spf13/cast"
 
 	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
 )
@@ -13,6 +21,7 @@ func resourceMongoDBAtlasProject() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceMongoDBAtlasProjectCreate,
 		Read:   resourceMongoDBAtlasProjectRead,
+		Update: resourceMongoDBAtlasProjectUpdate,
 		Delete: resourceMongoDBAtlasProjectDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -28,6 +37,23 @@ func resourceMongoDBAtlasProject() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"project_owner_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(userIDRegex, "must be the 24-character hexadecimal ID of an Atlas user"),
+				// Atlas doesn't return the owner, so it's unknown for imported projects.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != "" && old == ""
+				},
+			},
+			"region_usage_restrictions": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"COMMERCIAL_FEDRAMP_REGIONS_ONLY", "GOV_REGIONS_ONLY"}, false),
+			},
 			"cluster_count": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -36,34 +62,244 @@ func resourceMongoDBAtlasProject() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"is_collect_database_specifics_statistics_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"is_data_explorer_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"is_performance_advisor_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"is_realtime_performance_panel_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"is_schema_advisor_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"services": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"clusters": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"cluster_name": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"inbound": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"outbound": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"limits": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			"teams": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"team_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"role_names": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(projectTeamRoleNames, false),
+							},
+						},
+					},
+				},
+			},
+			"tags": resourceTagsSchema(),
+			"ip_access_list": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      filterParamsHash,
+				Elem:     projectIPWhitelistEntrySchema(),
+			},
 		},
 	}
 }
 
+const (
+	projectsPath             = "groups"
+	projectPath              = "groups/%s"
+	projectLimitsPath        = "groups/%s/limits/%s"
+	projectSettingsPath      = "groups/%s/settings"
+	projectIPAddressesPath   = "groups/%s/ipAddresses"
+	projectV2Path            = "../v2/groups/%s"
+	projectManagedSlowMsPath = "../v2/groups/%s/managedSlowMs"
+)
+
+// userIDRegex matches the ID of an Atlas user.
+var userIDRegex = regexp.MustCompile(`^[0-9a-f]{24}$`)
+
+// atlasProject is a project with the fields that the client doesn't support yet.
+// See more: https://docs.atlas.mongodb.com/reference/api/projects/
+type atlasProject struct {
+	matlas.Project
+	RegionUsageRestrictions string `json:"regionUsageRestrictions,omitempty"`
+}
+
+// projectV2Fields holds the project fields only exposed by the versioned API.
+// See more: https://www.mongodb.com/docs/atlas/reference/api-resources-spec/v2/#tag/Projects/operation/updateProject
+type projectV2Fields struct {
+	Tags *[]*resourceTag `json:"tags,omitempty"`
+}
+
+// projectLimit represents a configurable limit of a project.
+// See more: https://docs.atlas.mongodb.com/reference/api/project-limits/
+type projectLimit struct {
+	Name         string `json:"name,omitempty"`
+	Value        int64  `json:"value"`
+	CurrentUsage int64  `json:"currentUsage,omitempty"`
+	DefaultLimit int64  `json:"defaultLimit,omitempty"`
+	MaximumLimit int64  `json:"maximumLimit,omitempty"`
+}
+
+// projectSettings represents the settings of a project.
+// See more: https://docs.atlas.mongodb.com/reference/api/project-settings/
+type projectSettings struct {
+	IsCollectDatabaseSpecificsStatisticsEnabled *bool `json:"isCollectDatabaseSpecificsStatisticsEnabled,omitempty"`
+	IsDataExplorerEnabled                       *bool `json:"isDataExplorerEnabled,omitempty"`
+	IsPerformanceAdvisorEnabled                 *bool `json:"isPerformanceAdvisorEnabled,omitempty"`
+	IsRealtimePerformancePanelEnabled           *bool `json:"isRealtimePerformancePanelEnabled,omitempty"`
+	IsSchemaAdvisorEnabled                      *bool `json:"isSchemaAdvisorEnabled,omitempty"`
+}
+
+// projectIPAddresses represents the IP addresses that Atlas uses for the services of a project.
+// See more: https://docs.atlas.mongodb.com/reference/api/project-ip-addresses/
+type projectIPAddresses struct {
+	GroupID  string `json:"groupId,omitempty"`
+	Services struct {
+		Clusters []*clusterIPAddresses `json:"clusters,omitempty"`
+	} `json:"services"`
+}
+
+type clusterIPAddresses struct {
+	ClusterName string   `json:"clusterName,omitempty"`
+	Inbound     []string `json:"inbound,omitempty"`
+	Outbound    []string `json:"outbound,omitempty"`
+}
+
 func resourceMongoDBAtlasProjectCreate(d *schema.ResourceData, meta interface{}) error {
 	//Get client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 
-	projectReq := &matlas.Project{
-		OrgID: d.Get("org_id").(string),
-		Name:  d.Get("name").(string),
+	projectReq := &atlasProject{
+		Project: matlas.Project{
+			OrgID: d.Get("org_id").(string),
+			Name:  d.Get("name").(string),
+		},
+		RegionUsageRestrictions: d.Get("region_usage_restrictions").(string),
 	}
 
-	projectRes, _, err := conn.Projects.Create(context.Background(), projectReq)
+	ownerID := d.Get("project_owner_id").(string)
+
+	projectRes, resp, err := createProject(conn, projectReq, ownerID)
 	if err != nil {
+		if ownerID != "" && resp != nil && (resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusNotFound) {
+			return fmt.Errorf("error creating project owned by user (%s), the user must exist and be a member of organization (%s): %s", ownerID, projectReq.OrgID, err)
+		}
 		return fmt.Errorf("error creating project: %s", err)
 	}
 
 	d.SetId(projectRes.ID)
+
+	for _, l := range expandProjectLimits(d.Get("limits").(*schema.Set).List()) {
+		if _, err := setProjectLimit(conn, projectRes.ID, l); err != nil {
+			return fmt.Errorf("error setting limit `%s` for project (%s): %s", l.Name, projectRes.ID, err)
+		}
+	}
+
+	if settings := expandProjectSettings(d, false); settings != nil {
+		if _, _, err := updateProjectSettings(conn, projectRes.ID, settings); err != nil {
+			return fmt.Errorf("error updating settings for project (%s): %s", projectRes.ID, err)
+		}
+	}
+
+	if teams := expandProjectTeams(d.Get("teams").(*schema.Set).List()); len(teams) > 0 {
+		if err := addProjectTeams(conn, projectRes.ID, teams); err != nil {
+			return fmt.Errorf("error assigning teams to project (%s): %s", projectRes.ID, err)
+		}
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		if err := updateProjectTags(conn, projectRes.ID, expandResourceTags(v.(*schema.Set))); err != nil {
+			return fmt.Errorf("error setting tags for project (%s): %s", projectRes.ID, err)
+		}
+	}
+
+	if entries := expandProjectIPEntries(d, "ip_access_list"); len(entries) > 0 {
+		meta.(*MongoDBClient).projectMutexKV.Lock(projectRes.ID)
+		defer meta.(*MongoDBClient).projectMutexKV.Unlock(projectRes.ID)
+
+		if err := updateProjectIPAccessList(conn, projectRes.ID, nil, entries); err != nil {
+			return fmt.Errorf("error setting the IP access list of project (%s): %s", projectRes.ID, err)
+		}
+	}
+
 	return resourceMongoDBAtlasProjectRead(d, meta)
 }
 
 func resourceMongoDBAtlasProjectRead(d *schema.ResourceData, meta interface{}) error {
 	//Get client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 	projectID := d.Id()
 
-	projectRes, _, err := conn.Projects.GetOneProject(context.Background(), projectID)
+	projectRes, _, err := getProject(conn, projectID)
 	if err != nil {
 		return fmt.Errorf("error getting project information: %s", err)
 	}
@@ -74,18 +310,164 @@ func resourceMongoDBAtlasProjectRead(d *schema.ResourceData, meta interface{}) e
 	if err := d.Set("org_id", projectRes.OrgID); err != nil {
 		return fmt.Errorf("error setting `org_id` for project (%s): %s", d.Id(), err)
 	}
+	if err := d.Set("region_usage_restrictions", projectRes.RegionUsageRestrictions); err != nil {
+		return fmt.Errorf("error setting `region_usage_restrictions` for project (%s): %s", d.Id(), err)
+	}
 	if err := d.Set("cluster_count", projectRes.ClusterCount); err != nil {
 		return fmt.Errorf("error setting `clusterCount` for project (%s): %s", d.Id(), err)
 	}
 	if err := d.Set("created", projectRes.Created); err != nil {
 		return fmt.Errorf("error setting `created` for project (%s): %s", d.Id(), err)
 	}
+
+	settings, _, err := getProjectSettings(conn, projectID)
+	if err != nil {
+		return fmt.Errorf("error getting settings for project (%s): %s", projectID, err)
+	}
+	if err := d.Set("is_collect_database_specifics_statistics_enabled", settings.IsCollectDatabaseSpecificsStatisticsEnabled); err != nil {
+		return fmt.Errorf("error setting `is_collect_database_specifics_statistics_enabled` for project (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("is_data_explorer_enabled", settings.IsDataExplorerEnabled); err != nil {
+		return fmt.Errorf("error setting `is_data_explorer_enabled` for project (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("is_performance_advisor_enabled", settings.IsPerformanceAdvisorEnabled); err != nil {
+		return fmt.Errorf("error setting `is_performance_advisor_enabled` for project (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("is_realtime_performance_panel_enabled", settings.IsRealtimePerformancePanelEnabled); err != nil {
+		return fmt.Errorf("error setting `is_realtime_performance_panel_enabled` for project (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("is_schema_advisor_enabled", settings.IsSchemaAdvisorEnabled); err != nil {
+		return fmt.Errorf("error setting `is_schema_advisor_enabled` for project (%s): %s", d.Id(), err)
+	}
+
+	ipAddresses, _, err := getProjectIPAddresses(conn, projectID)
+	if err != nil {
+		return fmt.Errorf("error getting ip addresses for project (%s): %s", projectID, err)
+	}
+	if err := d.Set("ip_addresses", flattenProjectIPAddresses(ipAddresses)); err != nil {
+		return fmt.Errorf("error setting `ip_addresses` for project (%s): %s", d.Id(), err)
+	}
+
+	v2Fields := new(projectV2Fields)
+	if _, err := doAtlasV2Request(conn, http.MethodGet, fmt.Sprintf(projectV2Path, projectID), nil, v2Fields); err != nil {
+		return fmt.Errorf("error getting tags for project (%s): %s", projectID, err)
+	}
+	if err := d.Set("tags", flattenResourceTags(v2Fields.Tags)); err != nil {
+		return fmt.Errorf("error setting `tags` for project (%s): %s", d.Id(), err)
+	}
+
+	// Only the limits declared by the user are reconciled, Atlas defaults are left untouched.
+	limits := make([]map[string]interface{}, 0)
+	for _, l := range expandProjectLimits(d.Get("limits").(*schema.Set).List()) {
+		limit, _, err := getProjectLimit(conn, projectID, l.Name)
+		if err != nil {
+			return fmt.Errorf("error getting limit `%s` for project (%s): %s", l.Name, projectID, err)
+		}
+		limits = append(limits, map[string]interface{}{
+			"name":  limit.Name,
+			"value": limit.Value,
+		})
+	}
+	if err := d.Set("limits", limits); err != nil {
+		return fmt.Errorf("error setting `limits` for project (%s): %s", d.Id(), err)
+	}
+
+	// Like the limits, only the declared teams are reconciled so that the teams assigned with
+	// mongodbatlas_project_team or in the console don't show up as drift.
+	if declared := expandProjectTeams(d.Get("teams").(*schema.Set).List()); len(declared) > 0 {
+		current, err := listProjectTeams(conn, projectID)
+		if err != nil {
+			return fmt.Errorf("error getting teams for project (%s): %s", projectID, err)
+		}
+		if err := d.Set("teams", flattenProjectTeams(declared, current)); err != nil {
+			return fmt.Errorf("error setting `teams` for project (%s): %s", d.Id(), err)
+		}
+	}
+
+	// Unlike the teams, a declared access list owns every entry of the project, so the entries
+	// added outside of the project resource show up as drift and are removed on the next apply.
+	if d.Get("ip_access_list").(*schema.Set).Len() > 0 {
+		var entries []projectIPWhitelist
+		_, err := listProjectIPAccessList(conn, projectID, projectIPAccessListMaxItemsPerPage, func(entry *projectIPWhitelist) {
+			entries = append(entries, *entry)
+		})
+		if err != nil {
+			return fmt.Errorf("error getting the IP access list of project (%s): %s", projectID, err)
+		}
+		if err := d.Set("ip_access_list", flattenProjectIPWhitelist(entries)); err != nil {
+			return fmt.Errorf("error setting `ip_access_list` for project (%s): %s", d.Id(), err)
+		}
+	}
 	return nil
 }
 
+func resourceMongoDBAtlasProjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Id()
+
+	if d.HasChange("limits") {
+		o, n := d.GetChange("limits")
+		newLimits := expandProjectLimits(n.(*schema.Set).List())
+
+		declared := make(map[string]bool)
+		for _, l := range newLimits {
+			declared[l.Name] = true
+		}
+
+		// Limits that are no longer declared are reset to the Atlas default.
+		for _, l := range expandProjectLimits(o.(*schema.Set).List()) {
+			if declared[l.Name] {
+				continue
+			}
+			if _, err := deleteProjectLimit(conn, projectID, l.Name); err != nil {
+				return fmt.Errorf("error removing limit `%s` for project (%s): %s", l.Name, projectID, err)
+			}
+		}
+
+		for _, l := range newLimits {
+			if _, err := setProjectLimit(conn, projectID, l); err != nil {
+				return fmt.Errorf("error setting limit `%s` for project (%s): %s", l.Name, projectID, err)
+			}
+		}
+	}
+
+	if settings := expandProjectSettings(d, true); settings != nil {
+		if _, _, err := updateProjectSettings(conn, projectID, settings); err != nil {
+			return fmt.Errorf("error updating settings for project (%s): %s", projectID, err)
+		}
+	}
+
+	if d.HasChange("teams") {
+		o, n := d.GetChange("teams")
+		if err := updateProjectTeams(conn, projectID, expandProjectTeams(o.(*schema.Set).List()), expandProjectTeams(n.(*schema.Set).List())); err != nil {
+			return fmt.Errorf("error updating teams for project (%s): %s", projectID, err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := updateProjectTags(conn, projectID, expandResourceTags(d.Get("tags").(*schema.Set))); err != nil {
+			return fmt.Errorf("error setting tags for project (%s): %s", projectID, err)
+		}
+	}
+
+	if d.HasChange("ip_access_list") {
+		//Serialize access list mutations of the same project.
+		meta.(*MongoDBClient).projectMutexKV.Lock(projectID)
+		defer meta.(*MongoDBClient).projectMutexKV.Unlock(projectID)
+
+		o, n := d.GetChange("ip_access_list")
+		if err := updateProjectIPAccessList(conn, projectID, expandProjectIPEntryList(o.(*schema.Set).List()), expandProjectIPEntryList(n.(*schema.Set).List())); err != nil {
+			return fmt.Errorf("error updating the IP access list of project (%s): %s", projectID, err)
+		}
+	}
+
+	return resourceMongoDBAtlasProjectRead(d, meta)
+}
+
 func resourceMongoDBAtlasProjectDelete(d *schema.ResourceData, meta interface{}) error {
 	//Get client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 	projectID := d.Id()
 
 	_, err := conn.Projects.Delete(context.Background(), projectID)
@@ -94,3 +476,347 @@ func resourceMongoDBAtlasProjectDelete(d *schema.ResourceData, meta interface{})
 	}
 	return nil
 }
+
+// createProject creates the project, owned by the given user when ownerID is set instead of the
+// user of the API key.
+func createProject(conn *matlas.Client, project *atlasProject, ownerID string) (*atlasProject, *matlas.Response, error) {
+	path := projectsPath
+	if ownerID != "" {
+		path += "?projectOwnerId=" + url.QueryEscape(ownerID)
+	}
+
+	req, err := conn.NewRequest(context.Background(), http.MethodPost, path, project)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(atlasProject)
+	resp, err := conn.Do(context.Background(), req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
+
+func getProject(conn *matlas.Client, projectID string) (*atlasProject, *matlas.Response, error) {
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, fmt.Sprintf(projectPath, projectID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(atlasProject)
+	resp, err := conn.Do(context.Background(), req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
+
+// updateProjectTags replaces the tags of the project, an empty list removes them all.
+func updateProjectTags(conn *matlas.Client, projectID string, tags *[]*resourceTag) error {
+	_, err := doAtlasV2Request(conn, http.MethodPatch, fmt.Sprintf(projectV2Path, projectID), &projectV2Fields{Tags: tags}, nil)
+	return err
+}
+
+// updateProjectIPAccessList replaces the whole access list of the project with the new entries:
+// it reads the list once, adds or updates the new entries in a single request, then removes the
+// other ones. Removing every entry from the configuration only removes the old entries, the
+// access list is then left to the standalone resources.
+func updateProjectIPAccessList(conn *matlas.Client, projectID string, oldEntries, newEntries []*projectIPWhitelist) error {
+	if len(newEntries) == 0 {
+		for _, entry := range oldEntries {
+			if err := deleteProjectIPAccessListEntry(conn, projectID, projectIPEntryKey(entry)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := checkProjectIPWhitelistPeering(conn, projectID, newEntries); err != nil {
+		return err
+	}
+
+	var current []string
+	_, err := listProjectIPAccessList(conn, projectID, projectIPAccessListMaxItemsPerPage, func(entry *projectIPWhitelist) {
+		current = append(current, projectIPEntryKey(entry))
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err := createProjectIPEntries(conn, projectIPAccessListPath, projectID, newEntries); err != nil {
+		return err
+	}
+
+	declared := make(map[string]bool, len(newEntries))
+	for _, entry := range newEntries {
+		declared[projectIPEntryKey(entry)] = true
+	}
+	for _, key := range current {
+		if declared[key] {
+			continue
+		}
+		if err := deleteProjectIPAccessListEntry(conn, projectID, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// projectIPEntryKey identifies an access list entry, an IP address being the same entry as its
+// single address CIDR block.
+func projectIPEntryKey(entry *projectIPWhitelist) string {
+	if entry.AwsSecurityGroup != "" {
+		return entry.AwsSecurityGroup
+	}
+	if entry.CIDRBlock != "" {
+		return normalizeCIDRBlock(entry.CIDRBlock)
+	}
+	return normalizeCIDRBlock(entry.IPAddress)
+}
+
+func deleteProjectIPAccessListEntry(conn *matlas.Client, projectID, entry string) error {
+	path := fmt.Sprintf(projectIPAccessListPath+"/%s", projectID, url.PathEscape(entry))
+
+	req, err := conn.NewRequest(context.Background(), http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do(context.Background(), req, nil)
+	return err
+}
+
+func expandProjectLimits(limits []interface{}) []*projectLimit {
+	result := make([]*projectLimit, 0, len(limits))
+	for _, l := range limits {
+		limit := l.(map[string]interface{})
+		result = append(result, &projectLimit{
+			Name:  cast.ToString(limit["name"]),
+			Value: cast.ToInt64(limit["value"]),
+		})
+	}
+	return result
+}
+
+func getProjectLimit(conn *matlas.Client, projectID, name string) (*projectLimit, *matlas.Response, error) {
+	path := fmt.Sprintf(projectLimitsPath, projectID, url.PathEscape(name))
+
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(projectLimit)
+	resp, err := conn.Do(context.Background(), req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
+
+func setProjectLimit(conn *matlas.Client, projectID string, limit *projectLimit) (*matlas.Response, error) {
+	path := fmt.Sprintf(projectLimitsPath, projectID, url.PathEscape(limit.Name))
+
+	req, err := conn.NewRequest(context.Background(), http.MethodPatch, path, limit)
+	if err != nil {
+		return nil, err
+	}
+	return conn.Do(context.Background(), req, nil)
+}
+
+func deleteProjectLimit(conn *matlas.Client, projectID, name string) (*matlas.Response, error) {
+	path := fmt.Sprintf(projectLimitsPath, projectID, url.PathEscape(name))
+
+	req, err := conn.NewRequest(context.Background(), http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return conn.Do(context.Background(), req, nil)
+}
+
+func expandProjectTeams(teams []interface{}) []*projectTeam {
+	result := make([]*projectTeam, 0, len(teams))
+	for _, t := range teams {
+		team := t.(map[string]interface{})
+		roleNames := expandStringSet(team["role_names"].(*schema.Set))
+		sort.Strings(roleNames)
+		result = append(result, &projectTeam{
+			TeamID:    cast.ToString(team["team_id"]),
+			RoleNames: roleNames,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].TeamID < result[j].TeamID })
+	return result
+}
+
+// flattenProjectTeams returns the current roles of the declared teams. The teams that aren't
+// assigned to the project anymore are left out, so that they are assigned again.
+func flattenProjectTeams(declared, current []*projectTeam) []map[string]interface{} {
+	assigned := make(map[string]*projectTeam)
+	for _, team := range current {
+		assigned[team.TeamID] = team
+	}
+
+	results := make([]map[string]interface{}, 0, len(declared))
+	for _, team := range declared {
+		if t, ok := assigned[team.TeamID]; ok {
+			results = append(results, map[string]interface{}{
+				"team_id":    t.TeamID,
+				"role_names": t.RoleNames,
+			})
+		}
+	}
+	return results
+}
+
+// updateProjectTeams only applies the differences between the old and new teams: the teams
+// removed from the configuration are unassigned, the roles of the changed teams are updated and
+// the new teams are assigned, leaving the other teams untouched.
+func updateProjectTeams(conn *matlas.Client, projectID string, oldTeams, newTeams []*projectTeam) error {
+	previous := make(map[string]*projectTeam)
+	for _, team := range oldTeams {
+		previous[team.TeamID] = team
+	}
+	declared := make(map[string]bool)
+	for _, team := range newTeams {
+		declared[team.TeamID] = true
+	}
+
+	for _, team := range oldTeams {
+		if declared[team.TeamID] {
+			continue
+		}
+		if err := removeProjectTeam(conn, projectID, team.TeamID); err != nil {
+			return fmt.Errorf(errorProjectTeamDelete, team.TeamID, projectID, err)
+		}
+	}
+
+	var added []*projectTeam
+	for _, team := range newTeams {
+		prior, ok := previous[team.TeamID]
+		if !ok {
+			added = append(added, team)
+			continue
+		}
+		if reflect.DeepEqual(prior.RoleNames, team.RoleNames) {
+			continue
+		}
+		if err := updateProjectTeamRoles(conn, projectID, team.TeamID, team.RoleNames); err != nil {
+			return fmt.Errorf(errorProjectTeamUpdate, team.TeamID, projectID, err)
+		}
+	}
+
+	if len(added) > 0 {
+		if err := addProjectTeams(conn, projectID, added); err != nil {
+			return fmt.Errorf("error assigning teams to project (%s): %s", projectID, err)
+		}
+	}
+	return nil
+}
+
+// expandProjectSettings returns the settings set in the configuration, or only the
+// changed ones when onlyChanges is true. It returns nil if there is nothing to apply.
+func expandProjectSettings(d *schema.ResourceData, onlyChanges bool) *projectSettings {
+	settings := &projectSettings{}
+	hasSettings := false
+
+	setting := func(key string) *bool {
+		if onlyChanges && !d.HasChange(key) {
+			return nil
+		}
+		if v, ok := d.GetOkExists(key); ok {
+			hasSettings = true
+			return pointy.Bool(v.(bool))
+		}
+		return nil
+	}
+
+	settings.IsCollectDatabaseSpecificsStatisticsEnabled = setting("is_collect_database_specifics_statistics_enabled")
+	settings.IsDataExplorerEnabled = setting("is_data_explorer_enabled")
+	settings.IsPerformanceAdvisorEnabled = setting("is_performance_advisor_enabled")
+	settings.IsRealtimePerformancePanelEnabled = setting("is_realtime_performance_panel_enabled")
+	settings.IsSchemaAdvisorEnabled = setting("is_schema_advisor_enabled")
+
+	if !hasSettings {
+		return nil
+	}
+	return settings
+}
+
+func getProjectSettings(conn *matlas.Client, projectID string) (*projectSettings, *matlas.Response, error) {
+	path := fmt.Sprintf(projectSettingsPath, projectID)
+
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(projectSettings)
+	resp, err := conn.Do(context.Background(), req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
+
+// getProjectSlowOperationThresholding returns whether Atlas manages the slow operation threshold
+// of the clusters of the project, only exposed by the versioned API.
+// See more: https://www.mongodb.com/docs/atlas/reference/api-resources-spec/v2/#tag/Performance-Advisor/operation/getManagedSlowMs
+func getProjectSlowOperationThresholding(conn *matlas.Client, projectID string) (bool, *matlas.Response, error) {
+	var enabled bool
+	resp, err := doAtlasV2Request(conn, http.MethodGet, fmt.Sprintf(projectManagedSlowMsPath, projectID), nil, &enabled)
+	return enabled, resp, err
+}
+
+func updateProjectSettings(conn *matlas.Client, projectID string, settings *projectSettings) (*projectSettings, *matlas.Response, error) {
+	path := fmt.Sprintf(projectSettingsPath, projectID)
+
+	req, err := conn.NewRequest(context.Background(), http.MethodPatch, path, settings)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(projectSettings)
+	resp, err := conn.Do(context.Background(), req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
+
+func getProjectIPAddresses(conn *matlas.Client, projectID string) (*projectIPAddresses, *matlas.Response, error) {
+	path := fmt.Sprintf(projectIPAddressesPath, projectID)
+
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(projectIPAddresses)
+	resp, err := conn.Do(context.Background(), req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
+
+func flattenProjectIPAddresses(ipAddresses *projectIPAddresses) []map[string]interface{} {
+	clusters := make([]map[string]interface{}, 0, len(ipAddresses.Services.Clusters))
+	for _, cluster := range ipAddresses.Services.Clusters {
+		clusters = append(clusters, map[string]interface{}{
+			"cluster_name": cluster.ClusterName,
+			"inbound":      cluster.Inbound,
+			"outbound":     cluster.Outbound,
+		})
+	}
+
+	return []map[string]interface{}{
+		{
+			"services": []map[string]interface{}{
+				{
+					"clusters": clusters,
+				},
+			},
+		},
+	}
+}
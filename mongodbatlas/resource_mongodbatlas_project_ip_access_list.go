@@ -0,0 +1,124 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	errorProjectIPAccessListRead = "error getting project IP access list information: %s"
+
+	projectIPAccessListPath = "groups/%s/accessList"
+)
+
+func resourceMongoDBAtlasProjectIPAccessList() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMongoDBAtlasProjectIPAccessListCreate,
+		Read:   resourceMongoDBAtlasProjectIPAccessListRead,
+		Delete: resourceMongoDBAtlasProjectIPAccessListDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"access_list": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Set:      filterParamsHash,
+				Elem:     projectIPWhitelistEntrySchema(),
+			},
+		},
+	}
+}
+
+func resourceMongoDBAtlasProjectIPAccessListCreate(d *schema.ResourceData, meta interface{}) error {
+	//Get the client connection.
+	conn := meta.(*MongoDBClient).Atlas
+
+	projectID := d.Get("project_id").(string)
+
+	//Serialize access list mutations of the same project.
+	meta.(*MongoDBClient).projectMutexKV.Lock(projectID)
+	defer meta.(*MongoDBClient).projectMutexKV.Unlock(projectID)
+
+	req := expandProjectIPEntries(d, "access_list")
+	if err := checkProjectIPWhitelistPeering(conn, projectID, req); err != nil {
+		return err
+	}
+
+	resp, err := createProjectIPEntries(conn, projectIPAccessListPath, projectID, req)
+	if err != nil {
+		return fmt.Errorf("error creating project IP access list: %s", err)
+	}
+
+	var accessList []string
+	whiteListMap(resp, func(entry string) {
+		accessList = append(accessList, entry)
+	})
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id": projectID,
+		"entries":    strings.Join(accessList, ","),
+	}))
+
+	return resourceMongoDBAtlasProjectIPAccessListRead(d, meta)
+}
+
+func resourceMongoDBAtlasProjectIPAccessListRead(d *schema.ResourceData, meta interface{}) error {
+	//Get the client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+
+	accessList, err := getProjectIPEntries(conn, projectIPAccessListPath, ids)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("access_list", flattenProjectIPWhitelist(accessList)); err != nil {
+		return fmt.Errorf(errorProjectIPAccessListRead, err)
+	}
+	return nil
+}
+
+func resourceMongoDBAtlasProjectIPAccessListDelete(d *schema.ResourceData, meta interface{}) error {
+	//Get the client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+
+	//Serialize access list mutations of the same project.
+	meta.(*MongoDBClient).projectMutexKV.Lock(ids["project_id"])
+	defer meta.(*MongoDBClient).projectMutexKV.Unlock(ids["project_id"])
+
+	accessList, err := getProjectIPEntries(conn, projectIPAccessListPath, ids)
+	if err != nil {
+		return err
+	}
+
+	whiteListMap(accessList, func(entry string) {
+		if err != nil {
+			return
+		}
+
+		path := fmt.Sprintf(projectIPAccessListPath+"/%s", ids["project_id"], url.PathEscape(entry))
+
+		req, reqErr := conn.NewRequest(context.Background(), http.MethodDelete, path, nil)
+		if reqErr != nil {
+			err = reqErr
+			return
+		}
+		_, err = conn.Do(context.Background(), req, nil)
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting project IP access list: %s", err)
+	}
+	return nil
+}
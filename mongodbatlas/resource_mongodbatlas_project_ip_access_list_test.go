@@ -0,0 +1,203 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+func TestAccResourceMongoDBAtlasProjectIPAccessList_basic(t *testing.T) {
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+	ipAddress := fmt.Sprintf("179.154.228.%d", acctest.RandIntRange(0, 255))
+	cidrBlock := fmt.Sprintf("179.154.229.%d/32", acctest.RandIntRange(0, 255))
+
+	resourceName := "mongodbatlas_project_ip_access_list.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBAtlasProjectIPAccessListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasProjectIPAccessListConfig(projectID, ipAddress, cidrBlock),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasProjectIPAccessListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "project_id", projectID),
+					resource.TestCheckResourceAttr(resourceName, "access_list.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceMongoDBAtlasProjectIPAccessList_importWhitelist(t *testing.T) {
+	var requests []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		fmt.Fprint(w, `{"groupId": "5d09d6a59ccf6445652a444a", "ipAddress": "179.154.224.2", "cidrBlock": "179.154.224.2/32", "comment": "ip_address for tf acc testing"}`)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	meta := &MongoDBClient{Atlas: client}
+
+	// The ID of a mongodbatlas_project_ip_whitelist, removed from the state then imported.
+	r := resourceMongoDBAtlasProjectIPAccessList()
+	d := r.TestResourceData()
+	d.SetId(encodeStateID(map[string]string{
+		"project_id": "5d09d6a59ccf6445652a444a",
+		"entries":    "179.154.224.2",
+	}))
+
+	imported, err := r.Importer.State(d, meta)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := resourceMongoDBAtlasProjectIPAccessListRead(imported[0], meta); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := []string{"GET /groups/5d09d6a59ccf6445652a444a/accessList/179.154.224.2"}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Fatalf("expected requests %v, got %v", expected, requests)
+	}
+	if accessList := imported[0].Get("access_list").(*schema.Set); accessList.Len() != 1 {
+		t.Fatalf("expected the entry of the whitelist to be imported, got %v", accessList.List())
+	}
+}
+
+func TestNormalizeCIDRBlock(t *testing.T) {
+	cases := map[string]string{
+		"1.2.3.4":            "1.2.3.4/32",
+		"1.2.3.4/32":         "1.2.3.4/32",
+		"1.2.3.0/24":         "1.2.3.0/24",
+		"2001:db8::1":        "2001:db8::1/128",
+		"2001:0db8:0:0::1":   "2001:db8::1/128",
+		"2001:db8::/32":      "2001:db8::/32",
+		"":                   "",
+		"sg-0123456789abcde": "sg-0123456789abcde",
+	}
+
+	for cidrBlock, expected := range cases {
+		if normalized := normalizeCIDRBlock(cidrBlock); normalized != expected {
+			t.Fatalf("%s: expected %s, got %s", cidrBlock, expected, normalized)
+		}
+	}
+}
+
+func TestResourceMongoDBAtlasProjectIPAccessListDiff_bareIP(t *testing.T) {
+	hash := filterParamsHash(map[string]interface{}{"cidr_block": "1.2.3.4/32"})
+	state := &terraform.InstanceState{
+		ID: encodeStateID(map[string]string{
+			"project_id": "5d09d6a59ccf6445652a444a",
+			"entries":    "1.2.3.4/32",
+		}),
+		Attributes: map[string]string{
+			"id":            "",
+			"project_id":    "5d09d6a59ccf6445652a444a",
+			"access_list.#": "1",
+			fmt.Sprintf("access_list.%d.cidr_block", hash):         "1.2.3.4/32",
+			fmt.Sprintf("access_list.%d.ip_address", hash):         "",
+			fmt.Sprintf("access_list.%d.aws_security_group", hash): "",
+			fmt.Sprintf("access_list.%d.comment", hash):            "bare IP",
+		},
+	}
+
+	cases := []struct {
+		cidrBlock    string
+		expectChange bool
+	}{
+		{cidrBlock: "1.2.3.4", expectChange: false},
+		{cidrBlock: "1.2.3.4/32", expectChange: false},
+		{cidrBlock: "1.2.3.5", expectChange: true},
+	}
+
+	for _, c := range cases {
+		raw, err := config.NewRawConfig(map[string]interface{}{
+			"project_id": "5d09d6a59ccf6445652a444a",
+			"access_list": []interface{}{
+				map[string]interface{}{"cidr_block": c.cidrBlock, "comment": "bare IP"},
+			},
+		})
+		if err != nil {
+			t.Fatalf("%s: err: %s", c.cidrBlock, err)
+		}
+
+		diff, err := resourceMongoDBAtlasProjectIPAccessList().Diff(state, terraform.NewResourceConfig(raw), nil)
+		if err != nil {
+			t.Fatalf("%s: err: %s", c.cidrBlock, err)
+		}
+		if changed := diff != nil && !diff.Empty(); changed != c.expectChange {
+			t.Fatalf("%s: expected change %t, got diff %v", c.cidrBlock, c.expectChange, diff)
+		}
+	}
+}
+
+func testAccCheckMongoDBAtlasProjectIPAccessListExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*MongoDBClient).Atlas
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		if entries, _ := getProjectIPEntries(conn, projectIPAccessListPath, decodeStateID(rs.Primary.ID)); len(entries) > 0 {
+			return nil
+		}
+		return fmt.Errorf("project ip access list entry (%s) does not exist", rs.Primary.Attributes["project_id"])
+	}
+}
+
+func testAccCheckMongoDBAtlasProjectIPAccessListDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*MongoDBClient).Atlas
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongodbatlas_project_ip_access_list" {
+			continue
+		}
+
+		if entries, _ := getProjectIPEntries(conn, projectIPAccessListPath, decodeStateID(rs.Primary.ID)); len(entries) > 0 {
+			return fmt.Errorf("project ip access list entry (%s) still exists", rs.Primary.Attributes["project_id"])
+		}
+	}
+	return nil
+}
+
+func testAccMongoDBAtlasProjectIPAccessListConfig(projectID, ipAddress, cidrBlock string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_project_ip_access_list" "test" {
+			project_id = "%s"
+			access_list {
+				ip_address = "%s"
+				comment    = "ip_address for tf acc testing"
+			}
+			access_list {
+				cidr_block = "%s"
+				comment    = "cidr_block for tf acc testing"
+			}
+		}
+	`, projectID, ipAddress, cidrBlock)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
@@ -16,8 +18,21 @@ import (
 
 const (
 	errorGetInfo = "error getting project IP whitelist information: %s"
+
+	projectIPWhitelistPath = "groups/%s/whitelist"
 )
 
+// projectIPWhitelist is a project IP whitelist entry, it mirrors matlas.ProjectIPWhitelist and
+// adds the AWS security group entries, which aren't supported by the client yet.
+type projectIPWhitelist struct {
+	Comment          string `json:"comment,omitempty"`
+	GroupID          string `json:"groupId,omitempty"`
+	CIDRBlock        string `json:"cidrBlock,omitempty"`
+	IPAddress        string `json:"ipAddress,omitempty"`
+	AwsSecurityGroup string `json:"awsSecurityGroup,omitempty"`
+	DeleteAfterDate  string `json:"deleteAfterDate,omitempty"`
+}
+
 func resourceMongoDBAtlasProjectIPWhitelist() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceMongoDBAtlasProjectIPWhitelistCreate,
@@ -26,6 +41,7 @@ func resourceMongoDBAtlasProjectIPWhitelist() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		DeprecationMessage: "this resource is deprecated, Atlas renamed the IP whitelist to IP access list. Remove it from the state with `terraform state rm` and import its ID into mongodbatlas_project_ip_access_list, with `whitelist` renamed to `access_list`",
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:     schema.TypeString,
@@ -37,73 +53,115 @@ func resourceMongoDBAtlasProjectIPWhitelist() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 				Set:      filterParamsHash,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"cidr_block": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
-							ForceNew: true,
-							ValidateFunc: func(i interface{}, k string) (s []string, es []error) {
-								v, ok := i.(string)
-								if !ok {
-									es = append(es, fmt.Errorf("expected type of %s to be string", k))
-									return
-								}
-
-								_, ipnet, err := net.ParseCIDR(v)
-								if err != nil {
-									es = append(es, fmt.Errorf(
-										"expected %s to contain a valid CIDR, got: %s with err: %s", k, v, err))
-									return
-								}
-
-								if ipnet == nil || v != ipnet.String() {
-									es = append(es, fmt.Errorf(
-										"expected %s to contain a valid network CIDR, expected %s, got %s",
-										k, ipnet, v))
-									return
-								}
-								return
-							},
-						},
-						"ip_address": {
-							Type:         schema.TypeString,
-							ForceNew:     true,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validation.SingleIP(),
-						},
-						"comment": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validation.NoZeroValues,
-						},
-					},
+				Elem:     projectIPWhitelistEntrySchema(),
+			},
+		},
+	}
+}
+
+// projectIPWhitelistEntrySchema is the schema of an entry, shared with the access list resource.
+func projectIPWhitelistEntrySchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"cidr_block": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: cidrBlockDiffSuppressFunc,
+				ValidateFunc: func(i interface{}, k string) (s []string, es []error) {
+					v, ok := i.(string)
+					if !ok {
+						es = append(es, fmt.Errorf("expected type of %s to be string", k))
+						return
+					}
+
+					v = normalizeCIDRBlock(v)
+					_, ipnet, err := net.ParseCIDR(v)
+					if err != nil {
+						es = append(es, fmt.Errorf(
+							"expected %s to contain a valid CIDR, got: %s with err: %s", k, v, err))
+						return
+					}
+
+					if ipnet == nil || v != ipnet.String() {
+						es = append(es, fmt.Errorf(
+							"expected %s to contain a valid network CIDR, expected %s, got %s",
+							k, ipnet, v))
+						return
+					}
+					return
 				},
 			},
+			"ip_address": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.SingleIP(),
+			},
+			"aws_security_group": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+				Computed: true,
+			},
+			"comment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
 		},
 	}
 }
 
 func filterParamsHash(v interface{}) int {
 	entry := v.(map[string]interface{})
+	if cast.ToString(entry["aws_security_group"]) != "" {
+		return hashcode.String(cast.ToString(entry["aws_security_group"]))
+	}
 	if cast.ToString(entry["ip_address"]) != "" {
 		return hashcode.String(cast.ToString(entry["ip_address"]))
 	}
-	ip, _, _ := net.ParseCIDR(cast.ToString(entry["cidr_block"]))
+	ip, _, _ := net.ParseCIDR(normalizeCIDRBlock(cast.ToString(entry["cidr_block"])))
 	return hashcode.String(ip.String())
 }
 
+// normalizeCIDRBlock returns the CIDR of a single address for a bare IP, i.e. with a /32 prefix
+// for IPv4 and /128 for IPv6, like Atlas stores it. Other values are returned as is.
+func normalizeCIDRBlock(cidrBlock string) string {
+	ip := net.ParseIP(cidrBlock)
+	if ip == nil {
+		return cidrBlock
+	}
+	if ip.To4() != nil {
+		return ip.String() + "/32"
+	}
+	return ip.String() + "/128"
+}
+
+// cidrBlockDiffSuppressFunc suppresses the diff between a bare IP and its single address CIDR.
+func cidrBlockDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeCIDRBlock(old) == normalizeCIDRBlock(new)
+}
+
 func resourceMongoDBAtlasProjectIPWhitelistCreate(d *schema.ResourceData, meta interface{}) error {
 	//Get the client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 
 	projectID := d.Get("project_id").(string)
 
+	//Serialize whitelist mutations of the same project.
+	meta.(*MongoDBClient).projectMutexKV.Lock(projectID)
+	defer meta.(*MongoDBClient).projectMutexKV.Unlock(projectID)
+
 	req := expandProjectIPWhitelist(d)
-	resp, _, err := conn.ProjectIPWhitelist.Create(context.Background(), projectID, req)
+	if err := checkProjectIPWhitelistPeering(conn, projectID, req); err != nil {
+		return err
+	}
+
+	resp, err := createProjectIPWhitelist(conn, projectID, req)
 	if err != nil {
 		return fmt.Errorf("error creating project IP whitelist: %s", err)
 	}
@@ -123,7 +181,7 @@ func resourceMongoDBAtlasProjectIPWhitelistCreate(d *schema.ResourceData, meta i
 
 func resourceMongoDBAtlasProjectIPWhitelistRead(d *schema.ResourceData, meta interface{}) error {
 	//Get the client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 	ids := decodeStateID(d.Id())
 
 	whitelist, err := getProjectIPWhitelist(ids, conn)
@@ -138,9 +196,13 @@ func resourceMongoDBAtlasProjectIPWhitelistRead(d *schema.ResourceData, meta int
 
 func resourceMongoDBAtlasProjectIPWhitelistDelete(d *schema.ResourceData, meta interface{}) error {
 	//Get the client connection.
-	conn := meta.(*matlas.Client)
+	conn := meta.(*MongoDBClient).Atlas
 	ids := decodeStateID(d.Id())
 
+	//Serialize whitelist mutations of the same project.
+	meta.(*MongoDBClient).projectMutexKV.Lock(ids["project_id"])
+	defer meta.(*MongoDBClient).projectMutexKV.Unlock(ids["project_id"])
+
 	whitelist, err := getProjectIPWhitelist(ids, conn)
 	if err != nil {
 		return err
@@ -155,24 +217,86 @@ func resourceMongoDBAtlasProjectIPWhitelistDelete(d *schema.ResourceData, meta i
 	return nil
 }
 
-func getProjectIPWhitelist(ids map[string]string, conn *matlas.Client) ([]matlas.ProjectIPWhitelist, error) {
+func getProjectIPWhitelist(ids map[string]string, conn *matlas.Client) ([]projectIPWhitelist, error) {
+	return getProjectIPEntries(conn, projectIPWhitelistPath, ids)
+}
+
+// getProjectIPEntries gets the entries of the ID from the whitelist or the access list path.
+func getProjectIPEntries(conn *matlas.Client, listPath string, ids map[string]string) ([]projectIPWhitelist, error) {
 	projectID := ids["project_id"]
 	entries := strings.Split(ids["entries"], ",")
 
-	var whitelist []matlas.ProjectIPWhitelist
+	var whitelist []projectIPWhitelist
 	for _, entry := range entries {
-		res, _, err := conn.ProjectIPWhitelist.Get(context.Background(), projectID, entry)
+		path := fmt.Sprintf(listPath+"/%s", projectID, url.PathEscape(entry))
+
+		req, err := conn.NewRequest(context.Background(), http.MethodGet, path, nil)
 		if err != nil {
 			return nil, fmt.Errorf(errorGetInfo, err)
 		}
+
+		res := new(projectIPWhitelist)
+		if _, err := conn.Do(context.Background(), req, res); err != nil {
+			return nil, fmt.Errorf(errorGetInfo, err)
+		}
 		whitelist = append(whitelist, *res)
 	}
 	return whitelist, nil
 }
 
-func whiteListMap(whitelist []matlas.ProjectIPWhitelist, f func(string)) {
+func createProjectIPWhitelist(conn *matlas.Client, projectID string, whitelist []*projectIPWhitelist) ([]projectIPWhitelist, error) {
+	return createProjectIPEntries(conn, projectIPWhitelistPath, projectID, whitelist)
+}
+
+func createProjectIPEntries(conn *matlas.Client, listPath, projectID string, entries []*projectIPWhitelist) ([]projectIPWhitelist, error) {
+	req, err := conn.NewRequest(context.Background(), http.MethodPost, fmt.Sprintf(listPath, projectID), entries)
+	if err != nil {
+		return nil, err
+	}
+
+	root := new(struct {
+		Results []projectIPWhitelist `json:"results"`
+	})
+	if _, err := conn.Do(context.Background(), req, root); err != nil {
+		return nil, err
+	}
+	return root.Results, nil
+}
+
+// checkProjectIPWhitelistPeering verifies that the project has an active AWS VPC peering
+// connection before whitelisting AWS security groups, Atlas rejects them otherwise.
+func checkProjectIPWhitelistPeering(conn *matlas.Client, projectID string, whitelist []*projectIPWhitelist) error {
+	var securityGroups []string
 	for _, entry := range whitelist {
-		if entry.CIDRBlock != "" {
+		if entry.AwsSecurityGroup != "" {
+			securityGroups = append(securityGroups, entry.AwsSecurityGroup)
+		}
+	}
+	if len(securityGroups) == 0 {
+		return nil
+	}
+
+	peers, _, err := conn.Peers.List(context.Background(), projectID, nil)
+	if err != nil {
+		return fmt.Errorf("error getting network peering connections of project (%s): %s", projectID, err)
+	}
+
+	for _, peer := range peers {
+		if peer.VpcID != "" && peer.StatusName == "AVAILABLE" {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("error creating project IP whitelist: the AWS security groups %s require an active AWS VPC peering "+
+		"connection between project (%s) and the security groups' VPC, create it with mongodbatlas_network_peering first",
+		strings.Join(securityGroups, ", "), projectID)
+}
+
+func whiteListMap(whitelist []projectIPWhitelist, f func(string)) {
+	for _, entry := range whitelist {
+		if entry.AwsSecurityGroup != "" {
+			f(entry.AwsSecurityGroup)
+		} else if entry.CIDRBlock != "" {
 			f(entry.CIDRBlock)
 		} else if entry.IPAddress != "" {
 			f(entry.IPAddress)
@@ -180,32 +304,43 @@ func whiteListMap(whitelist []matlas.ProjectIPWhitelist, f func(string)) {
 	}
 }
 
-func flattenProjectIPWhitelist(whitelists []matlas.ProjectIPWhitelist) []map[string]interface{} {
+func flattenProjectIPWhitelist(whitelists []projectIPWhitelist) []map[string]interface{} {
 	results := make([]map[string]interface{}, 0)
 
 	for _, whitelist := range whitelists {
 		entry := map[string]interface{}{
-			"cidr_block": whitelist.CIDRBlock,
-			"ip_address": whitelist.IPAddress,
-			"comment":    whitelist.Comment,
+			"cidr_block":         whitelist.CIDRBlock,
+			"ip_address":         whitelist.IPAddress,
+			"aws_security_group": whitelist.AwsSecurityGroup,
+			"comment":            whitelist.Comment,
 		}
 		results = append(results, entry)
 	}
 	return results
 }
 
-func expandProjectIPWhitelist(d *schema.ResourceData) []*matlas.ProjectIPWhitelist {
-	var whitelist []*matlas.ProjectIPWhitelist
-	if v, ok := d.GetOk("whitelist"); ok {
-		if rs := v.(*schema.Set).List(); len(rs) > 0 {
-			whitelist = make([]*matlas.ProjectIPWhitelist, len(rs))
-			for k, r := range rs {
-				roleMap := r.(map[string]interface{})
-				whitelist[k] = &matlas.ProjectIPWhitelist{
-					CIDRBlock: roleMap["cidr_block"].(string),
-					IPAddress: roleMap["ip_address"].(string),
-					Comment:   roleMap["comment"].(string),
-				}
+func expandProjectIPWhitelist(d *schema.ResourceData) []*projectIPWhitelist {
+	return expandProjectIPEntries(d, "whitelist")
+}
+
+func expandProjectIPEntries(d *schema.ResourceData, key string) []*projectIPWhitelist {
+	if v, ok := d.GetOk(key); ok {
+		return expandProjectIPEntryList(v.(*schema.Set).List())
+	}
+	return nil
+}
+
+func expandProjectIPEntryList(rs []interface{}) []*projectIPWhitelist {
+	var whitelist []*projectIPWhitelist
+	if len(rs) > 0 {
+		whitelist = make([]*projectIPWhitelist, len(rs))
+		for k, r := range rs {
+			roleMap := r.(map[string]interface{})
+			whitelist[k] = &projectIPWhitelist{
+				CIDRBlock:        normalizeCIDRBlock(roleMap["cidr_block"].(string)),
+				IPAddress:        roleMap["ip_address"].(string),
+				AwsSecurityGroup: roleMap["aws_security_group"].(string),
+				Comment:          roleMap["comment"].(string),
 			}
 		}
 	}
@@ -1,19 +1,27 @@
 package mongodbatlas
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
 )
 
 func TestAccResourceMongoDBAtlasProjectIPWhitelist_basic(t *testing.T) {
 	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
-	var whitelist = []matlas.ProjectIPWhitelist{
+	var whitelist = []projectIPWhitelist{
 		{
 			IPAddress: fmt.Sprintf("179.154.224.%d", acctest.RandIntRange(0, 255)),
 		},
@@ -50,7 +58,7 @@ func TestAccResourceMongoDBAtlasProjectIPWhitelist_basic(t *testing.T) {
 
 func TestAccResourceMongoDBAtlasProjectIPWhitelist_importBasic(t *testing.T) {
 	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
-	var whitelist = []matlas.ProjectIPWhitelist{
+	var whitelist = []projectIPWhitelist{
 		{
 			IPAddress: fmt.Sprintf("179.154.224.%d", acctest.RandIntRange(0, 255)),
 		},
@@ -86,9 +94,108 @@ func TestAccResourceMongoDBAtlasProjectIPWhitelist_importBasic(t *testing.T) {
 	})
 }
 
-func testAccCheckMongoDBAtlasProjectIPWhitelistExists(resourceName string, whitelist *[]matlas.ProjectIPWhitelist) resource.TestCheckFunc {
+func TestResourceMongoDBAtlasProjectIPWhitelist_concurrentCreate(t *testing.T) {
+	var inFlight, overlaps int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			if atomic.AddInt32(&inFlight, 1) > 1 {
+				atomic.AddInt32(&overlaps, 1)
+			}
+			defer atomic.AddInt32(&inFlight, -1)
+
+			var entries []projectIPWhitelist
+			if err := json.NewDecoder(r.Body).Decode(&entries); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			time.Sleep(20 * time.Millisecond)
+
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"results": entries})
+		case http.MethodGet:
+			parts := strings.Split(r.URL.Path, "/")
+			_ = json.NewEncoder(w).Encode(projectIPWhitelist{IPAddress: parts[len(parts)-1]})
+		}
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	meta := &MongoDBClient{Atlas: client, projectMutexKV: newMutexKV()}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+
+	for i := 0; i < 10; i++ {
+		d := schema.TestResourceDataRaw(t, resourceMongoDBAtlasProjectIPWhitelist().Schema, map[string]interface{}{
+			"project_id": "5d09d6a59ccf6445652a444a",
+			"whitelist": []interface{}{
+				map[string]interface{}{
+					"ip_address": fmt.Sprintf("179.154.224.%d", i),
+					"comment":    "concurrent create",
+				},
+			},
+		})
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- resourceMongoDBAtlasProjectIPWhitelistCreate(d, meta)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+	if overlaps != 0 {
+		t.Fatalf("expected whitelist mutations of the same project to be serialized, got %d overlapping requests", overlaps)
+	}
+}
+
+func TestCheckProjectIPWhitelistPeering(t *testing.T) {
+	var peers []matlas.Peer
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"results": peers, "totalCount": len(peers)})
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	projectID := "5d09d6a59ccf6445652a444a"
+	whitelist := []*projectIPWhitelist{
+		{IPAddress: "179.154.224.2"},
+		{AwsSecurityGroup: "sg-0026348ec11780bd1"},
+	}
+
+	if err := checkProjectIPWhitelistPeering(client, projectID, whitelist[:1]); err != nil {
+		t.Fatalf("expected no error without security groups, got: %s", err)
+	}
+
+	peers = []matlas.Peer{{VpcID: "vpc-abc123", StatusName: "PENDING_ACCEPTANCE"}}
+	if err := checkProjectIPWhitelistPeering(client, projectID, whitelist); err == nil || !strings.Contains(err.Error(), "sg-0026348ec11780bd1") {
+		t.Fatalf("expected an error naming the security group, got: %v", err)
+	}
+
+	peers = append(peers, matlas.Peer{VpcID: "vpc-def456", StatusName: "AVAILABLE"})
+	if err := checkProjectIPWhitelistPeering(client, projectID, whitelist); err != nil {
+		t.Fatalf("expected no error with an active peering, got: %s", err)
+	}
+}
+
+func testAccCheckMongoDBAtlasProjectIPWhitelistExists(resourceName string, whitelist *[]projectIPWhitelist) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*matlas.Client)
+		conn := testAccProvider.Meta().(*MongoDBClient).Atlas
 
 		rs, ok := s.RootModule().Resources[resourceName]
 		if !ok {
@@ -117,7 +224,7 @@ func testAccCheckMongoDBAtlasProjectIPWhitelistAttributes(cidrBlockEntry string,
 }
 
 func testAccCheckMongoDBAtlasProjectIPWhitelistDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*matlas.Client)
+	conn := testAccProvider.Meta().(*MongoDBClient).Atlas
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "mongodbatlas_project_ip_whitelist" {
@@ -142,7 +249,7 @@ func testAccCheckMongoDBAtlasProjectIPWhitelistImportStateIDFunc(resourceName st
 	}
 }
 
-func testAccMongoDBAtlasProjectIPWhitelistConfig(projectID string, entry []matlas.ProjectIPWhitelist) string {
+func testAccMongoDBAtlasProjectIPWhitelistConfig(projectID string, entry []projectIPWhitelist) string {
 	return fmt.Sprintf(`
 		resource "mongodbatlas_project_ip_whitelist" "test" {
 			project_id    = "%s"
@@ -0,0 +1,249 @@
+package mongodbatlas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/spf13/cast"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+const (
+	projectTeamsPath       = "groups/%s/teams"
+	errorProjectTeamCreate = "error assigning team (%s) to project (%s): %s"
+	errorProjectTeamRead   = "error getting team (%s) of project (%s): %s"
+	errorProjectTeamUpdate = "error updating roles of team (%s) in project (%s): %s"
+	errorProjectTeamDelete = "error removing team (%s) from project (%s): %s"
+)
+
+// projectTeam represents the roles of a team in a project.
+// See more: https://docs.atlas.mongodb.com/reference/api/project-add-team/
+type projectTeam struct {
+	TeamID    string   `json:"teamId,omitempty"`
+	RoleNames []string `json:"roleNames"`
+}
+
+func resourceMongoDBAtlasProjectTeam() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMongoDBAtlasProjectTeamCreate,
+		Read:   resourceMongoDBAtlasProjectTeamRead,
+		Update: resourceMongoDBAtlasProjectTeamUpdate,
+		Delete: resourceMongoDBAtlasProjectTeamDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMongoDBAtlasProjectTeamImportState,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"team_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(projectTeamRoleNames, false),
+				},
+			},
+		},
+	}
+}
+
+// projectTeamRoleNames are the project roles that can be granted to a team.
+var projectTeamRoleNames = []string{
+	"GROUP_OWNER",
+	"GROUP_CLUSTER_MANAGER",
+	"GROUP_READ_ONLY",
+	"GROUP_DATA_ACCESS_ADMIN",
+	"GROUP_DATA_ACCESS_READ_WRITE",
+	"GROUP_DATA_ACCESS_READ_ONLY",
+}
+
+func resourceMongoDBAtlasProjectTeamCreate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+	teamID := d.Get("team_id").(string)
+
+	team := &projectTeam{
+		TeamID:    teamID,
+		RoleNames: expandStringSet(d.Get("role_names").(*schema.Set)),
+	}
+
+	if err := addProjectTeams(conn, projectID, []*projectTeam{team}); err != nil {
+		return fmt.Errorf(errorProjectTeamCreate, teamID, projectID, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id": projectID,
+		"team_id":    teamID,
+	}))
+
+	return resourceMongoDBAtlasProjectTeamRead(d, meta)
+}
+
+func resourceMongoDBAtlasProjectTeamRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	projectID := ids["project_id"]
+	teamID := ids["team_id"]
+
+	team, err := getProjectTeam(conn, projectID, teamID)
+	if err != nil {
+		return fmt.Errorf(errorProjectTeamRead, teamID, projectID, err)
+	}
+	if team == nil {
+		log.Printf("[WARN] Team (%s) isn't assigned to project (%s) anymore, removing it from state", teamID, projectID)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("role_names", team.RoleNames); err != nil {
+		return fmt.Errorf(errorProjectTeamRead, teamID, projectID, err)
+	}
+
+	return nil
+}
+
+func resourceMongoDBAtlasProjectTeamUpdate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	projectID := ids["project_id"]
+	teamID := ids["team_id"]
+
+	if d.HasChange("role_names") {
+		if err := updateProjectTeamRoles(conn, projectID, teamID, expandStringSet(d.Get("role_names").(*schema.Set))); err != nil {
+			return fmt.Errorf(errorProjectTeamUpdate, teamID, projectID, err)
+		}
+	}
+
+	return resourceMongoDBAtlasProjectTeamRead(d, meta)
+}
+
+func resourceMongoDBAtlasProjectTeamDelete(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	projectID := ids["project_id"]
+	teamID := ids["team_id"]
+
+	if err := removeProjectTeam(conn, projectID, teamID); err != nil {
+		return fmt.Errorf(errorProjectTeamDelete, teamID, projectID, err)
+	}
+	return nil
+}
+
+func resourceMongoDBAtlasProjectTeamImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*MongoDBClient).Atlas
+
+	parts := strings.SplitN(d.Id(), "-", 2)
+	if len(parts) != 2 {
+		return nil, errors.New("import format error: to import a project team, use the format {project_id}-{team_id}")
+	}
+
+	projectID := parts[0]
+	teamID := parts[1]
+
+	team, err := getProjectTeam(conn, projectID, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't import team %s of project %s, error: %s", teamID, projectID, err)
+	}
+	if team == nil {
+		return nil, fmt.Errorf("couldn't import team %s of project %s, error: the team isn't assigned to the project", teamID, projectID)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id": projectID,
+		"team_id":    teamID,
+	}))
+
+	if err := d.Set("project_id", projectID); err != nil {
+		log.Printf("[WARN] Error setting project_id for (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("team_id", teamID); err != nil {
+		log.Printf("[WARN] Error setting team_id for (%s): %s", d.Id(), err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getProjectTeam returns the team assigned to the project, or nil if it isn't assigned.
+func getProjectTeam(conn *matlas.Client, projectID, teamID string) (*projectTeam, error) {
+	teams, err := listProjectTeams(conn, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, team := range teams {
+		if team.TeamID == teamID {
+			return team, nil
+		}
+	}
+	return nil, nil
+}
+
+func listProjectTeams(conn *matlas.Client, projectID string) ([]*projectTeam, error) {
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, fmt.Sprintf(projectTeamsPath, projectID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	root := new(struct {
+		Results []*projectTeam `json:"results"`
+	})
+	if _, err := conn.Do(context.Background(), req, root); err != nil {
+		return nil, err
+	}
+	return root.Results, nil
+}
+
+func addProjectTeams(conn *matlas.Client, projectID string, teams []*projectTeam) error {
+	req, err := conn.NewRequest(context.Background(), http.MethodPost, fmt.Sprintf(projectTeamsPath, projectID), teams)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do(context.Background(), req, nil)
+	return err
+}
+
+func updateProjectTeamRoles(conn *matlas.Client, projectID, teamID string, roleNames []string) error {
+	req, err := conn.NewRequest(context.Background(), http.MethodPatch, fmt.Sprintf(projectTeamsPath+"/%s", projectID, teamID), &projectTeam{RoleNames: roleNames})
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do(context.Background(), req, nil)
+	return err
+}
+
+func removeProjectTeam(conn *matlas.Client, projectID, teamID string) error {
+	req, err := conn.NewRequest(context.Background(), http.MethodDelete, fmt.Sprintf(projectTeamsPath+"/%s", projectID, teamID), nil)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do(context.Background(), req, nil)
+	return err
+}
+
+func expandStringSet(set *schema.Set) []string {
+	result := make([]string, 0, set.Len())
+	for _, v := range set.List() {
+		result = append(result, cast.ToString(v))
+	}
+	return result
+}
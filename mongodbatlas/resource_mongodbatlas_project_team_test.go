@@ -0,0 +1,96 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccResourceMongoDBAtlasProjectTeam_basic(t *testing.T) {
+	resourceName := "mongodbatlas_project_team.test"
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+	teamID := os.Getenv("MONGODB_ATLAS_TEAM_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); checkTeamEnv(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBAtlasProjectTeamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasProjectTeamConfig(projectID, teamID, `"GROUP_READ_ONLY"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasProjectTeamExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "project_id", projectID),
+					resource.TestCheckResourceAttr(resourceName, "team_id", teamID),
+					resource.TestCheckResourceAttr(resourceName, "role_names.#", "1"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasProjectTeamConfig(projectID, teamID, `"GROUP_READ_ONLY", "GROUP_DATA_ACCESS_READ_ONLY"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasProjectTeamExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "role_names.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     fmt.Sprintf("%s-%s", projectID, teamID),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMongoDBAtlasProjectTeamExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*MongoDBClient).Atlas
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		ids := decodeStateID(rs.Primary.ID)
+		team, err := getProjectTeam(conn, ids["project_id"], ids["team_id"])
+		if err != nil {
+			return err
+		}
+		if team == nil {
+			return fmt.Errorf("team (%s) isn't assigned to project (%s)", ids["team_id"], ids["project_id"])
+		}
+		return nil
+	}
+}
+
+func testAccCheckMongoDBAtlasProjectTeamDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*MongoDBClient).Atlas
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongodbatlas_project_team" {
+			continue
+		}
+
+		ids := decodeStateID(rs.Primary.ID)
+		if team, _ := getProjectTeam(conn, ids["project_id"], ids["team_id"]); team != nil {
+			return fmt.Errorf("team (%s) is still assigned to project (%s)", ids["team_id"], ids["project_id"])
+		}
+	}
+	return nil
+}
+
+func testAccMongoDBAtlasProjectTeamConfig(projectID, teamID, roleNames string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_project_team" "test" {
+			project_id = "%s"
+			team_id    = "%s"
+			role_names = [%s]
+		}
+	`, projectID, teamID, roleNames)
+}
@@ -2,9 +2,13 @@ package mongodbatlas
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -35,6 +39,8 @@ func TestAccResourceMongoDBAtlasProject_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", projectName),
 					resource.TestCheckResourceAttr(resourceName, "org_id", orgID),
 					resource.TestCheckResourceAttr(resourceName, "cluster_count", clusterCount),
+					resource.TestCheckResourceAttr(resourceName, "ip_addresses.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ip_addresses.0.services.0.clusters.#", clusterCount),
 				),
 			},
 			{
@@ -51,6 +57,397 @@ func TestAccResourceMongoDBAtlasProject_basic(t *testing.T) {
 	})
 }
 
+func TestAccResourceMongoDBAtlasProject_withLimits(t *testing.T) {
+	var project matlas.Project
+
+	resourceName := "mongodbatlas_project.test"
+	projectName := fmt.Sprintf("testacc-project-%s", acctest.RandString(10))
+	orgID := os.Getenv("MONGODB_ATLAS_ORG_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBAtlasProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasProjectConfigWithLimits(projectName, orgID, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasProjectExists(resourceName, &project),
+					resource.TestCheckResourceAttr(resourceName, "name", projectName),
+					resource.TestCheckResourceAttr(resourceName, "limits.#", "1"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasProjectConfigWithLimits(projectName, orgID, 40),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasProjectExists(resourceName, &project),
+					resource.TestCheckResourceAttr(resourceName, "limits.#", "1"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasPropjectConfig(projectName, orgID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasProjectExists(resourceName, &project),
+					resource.TestCheckResourceAttr(resourceName, "limits.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceMongoDBAtlasProject_withSettings(t *testing.T) {
+	var project matlas.Project
+
+	resourceName := "mongodbatlas_project.test"
+	projectName := fmt.Sprintf("testacc-project-%s", acctest.RandString(10))
+	orgID := os.Getenv("MONGODB_ATLAS_ORG_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBAtlasProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasProjectConfigWithSettings(projectName, orgID, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasProjectExists(resourceName, &project),
+					resource.TestCheckResourceAttr(resourceName, "is_data_explorer_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "is_schema_advisor_enabled", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "is_performance_advisor_enabled"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasProjectConfigWithSettings(projectName, orgID, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasProjectExists(resourceName, &project),
+					resource.TestCheckResourceAttr(resourceName, "is_data_explorer_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "is_schema_advisor_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceMongoDBAtlasProject_withTeams(t *testing.T) {
+	var project matlas.Project
+
+	resourceName := "mongodbatlas_project.test"
+	projectName := fmt.Sprintf("testacc-project-%s", acctest.RandString(10))
+	orgID := os.Getenv("MONGODB_ATLAS_ORG_ID")
+	teamID := os.Getenv("MONGODB_ATLAS_TEAM_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); checkTeamEnv(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBAtlasProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasProjectConfigWithTeams(projectName, orgID, teamID, `"GROUP_READ_ONLY"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasProjectExists(resourceName, &project),
+					resource.TestCheckResourceAttr(resourceName, "teams.#", "1"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasProjectConfigWithTeams(projectName, orgID, teamID, `"GROUP_READ_ONLY", "GROUP_DATA_ACCESS_READ_ONLY"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasProjectExists(resourceName, &project),
+					resource.TestCheckResourceAttr(resourceName, "teams.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestUpdateProjectTeams(t *testing.T) {
+	var requests []string
+	var added []*projectTeam
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodPost {
+			_ = json.NewDecoder(r.Body).Decode(&added)
+		}
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	oldTeams := []*projectTeam{
+		{TeamID: "team-a", RoleNames: []string{"GROUP_READ_ONLY"}},
+		{TeamID: "team-b", RoleNames: []string{"GROUP_OWNER"}},
+		{TeamID: "team-c", RoleNames: []string{"GROUP_READ_ONLY"}},
+	}
+	newTeams := []*projectTeam{
+		{TeamID: "team-a", RoleNames: []string{"GROUP_CLUSTER_MANAGER"}},
+		{TeamID: "team-b", RoleNames: []string{"GROUP_OWNER"}},
+		{TeamID: "team-d", RoleNames: []string{"GROUP_READ_ONLY"}},
+	}
+
+	if err := updateProjectTeams(client, "5d09d6a59ccf6445652a444a", oldTeams, newTeams); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := []string{
+		"DELETE /groups/5d09d6a59ccf6445652a444a/teams/team-c",
+		"PATCH /groups/5d09d6a59ccf6445652a444a/teams/team-a",
+		"POST /groups/5d09d6a59ccf6445652a444a/teams",
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Fatalf("expected requests %v, got %v", expected, requests)
+	}
+	if len(added) != 1 || added[0].TeamID != "team-d" {
+		t.Fatalf("expected only team-d to be assigned, got %+v", added)
+	}
+
+	requests = nil
+	if err := updateProjectTeams(client, "5d09d6a59ccf6445652a444a", newTeams, newTeams); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(requests) != 0 {
+		t.Fatalf("expected no requests without changes, got %v", requests)
+	}
+}
+
+func TestAccResourceMongoDBAtlasProject_withIPAccessList(t *testing.T) {
+	var project matlas.Project
+
+	resourceName := "mongodbatlas_project.test"
+	projectName := fmt.Sprintf("testacc-project-%s", acctest.RandString(10))
+	orgID := os.Getenv("MONGODB_ATLAS_ORG_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBAtlasProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasProjectConfigWithIPAccessList(projectName, orgID, "10.0.0.0/24", "10.1.0.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasProjectExists(resourceName, &project),
+					resource.TestCheckResourceAttr(resourceName, "ip_access_list.#", "2"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasProjectConfigWithIPAccessList(projectName, orgID, "10.0.0.0/24", "10.2.0.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasProjectExists(resourceName, &project),
+					resource.TestCheckResourceAttr(resourceName, "ip_access_list.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestUpdateProjectIPAccessList(t *testing.T) {
+	var requests []string
+	var added []*projectIPWhitelist
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.EscapedPath())
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"results": [
+				{"cidrBlock": "10.0.0.0/24", "comment": "office"},
+				{"cidrBlock": "192.168.1.10/32", "ipAddress": "192.168.1.10"},
+				{"cidrBlock": "172.16.0.0/16", "comment": "added in the console"}
+			], "totalCount": 3}`)
+		case http.MethodPost:
+			_ = json.NewDecoder(r.Body).Decode(&added)
+			fmt.Fprint(w, `{"results": []}`)
+		default:
+			fmt.Fprint(w, `{}`)
+		}
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	oldEntries := []*projectIPWhitelist{
+		{CIDRBlock: "10.0.0.0/24", Comment: "office"},
+		{IPAddress: "192.168.1.10"},
+	}
+	newEntries := []*projectIPWhitelist{
+		{CIDRBlock: "10.0.0.0/24", Comment: "head office"},
+		{IPAddress: "192.168.1.10"},
+		{CIDRBlock: "10.1.0.0/24"},
+	}
+
+	if err := updateProjectIPAccessList(client, "5d09d6a59ccf6445652a444a", oldEntries, newEntries); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := []string{
+		"GET /groups/5d09d6a59ccf6445652a444a/accessList",
+		"POST /groups/5d09d6a59ccf6445652a444a/accessList",
+		"DELETE /groups/5d09d6a59ccf6445652a444a/accessList/172.16.0.0%2F16",
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Fatalf("expected requests %v, got %v", expected, requests)
+	}
+	if !reflect.DeepEqual(added, newEntries) {
+		t.Fatalf("expected all the declared entries to be sent at once, got %+v", added)
+	}
+
+	// Removing the block only removes the entries it declared.
+	requests = nil
+	if err := updateProjectIPAccessList(client, "5d09d6a59ccf6445652a444a", oldEntries, nil); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected = []string{
+		"DELETE /groups/5d09d6a59ccf6445652a444a/accessList/10.0.0.0%2F24",
+		"DELETE /groups/5d09d6a59ccf6445652a444a/accessList/192.168.1.10%2F32",
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Fatalf("expected requests %v, got %v", expected, requests)
+	}
+}
+
+func TestCreateProjectRegionUsageRestrictions(t *testing.T) {
+	var created map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/groups" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&created)
+		fmt.Fprint(w, `{"id": "5d09d6a59ccf6445652a444a", "name": "gov", "orgId": "5b93ff2f96e82120w0aaec19", "regionUsageRestrictions": "GOV_REGIONS_ONLY"}`)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	project, _, err := createProject(client, &atlasProject{
+		Project:                 matlas.Project{Name: "gov", OrgID: "5b93ff2f96e82120w0aaec19"},
+		RegionUsageRestrictions: "GOV_REGIONS_ONLY",
+	}, "")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if created["regionUsageRestrictions"] != "GOV_REGIONS_ONLY" || created["name"] != "gov" {
+		t.Fatalf("expected the region usage restrictions to be sent on creation, got %v", created)
+	}
+	if project.ID != "5d09d6a59ccf6445652a444a" || project.RegionUsageRestrictions != "GOV_REGIONS_ONLY" {
+		t.Fatalf("unexpected project %+v", project)
+	}
+}
+
+func TestCreateProjectOwner(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if owner := r.URL.Query().Get("projectOwnerId"); owner != "5b93ff2f96e82120d0aaec17" {
+			t.Errorf("expected the project owner to be sent on creation, got %q", owner)
+		}
+		fmt.Fprint(w, `{"id": "5d09d6a59ccf6445652a444a", "name": "owned", "orgId": "5b93ff2f96e82120d0aaec19"}`)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if _, _, err := createProject(client, &atlasProject{
+		Project: matlas.Project{Name: "owned", OrgID: "5b93ff2f96e82120d0aaec19"},
+	}, "5b93ff2f96e82120d0aaec17"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestValidateProjectOwnerID(t *testing.T) {
+	validateFunc := resourceMongoDBAtlasProject().Schema["project_owner_id"].ValidateFunc
+
+	cases := []struct {
+		ownerID     string
+		expectError bool
+	}{
+		{ownerID: "5b93ff2f96e82120d0aaec17", expectError: false},
+		{ownerID: "jane.doe@example.com", expectError: true},
+		{ownerID: "5b93ff2f96e82120d0aaec1", expectError: true},
+		{ownerID: "5B93FF2F96E82120D0AAEC17", expectError: true},
+	}
+
+	for _, c := range cases {
+		if _, errs := validateFunc(c.ownerID, "project_owner_id"); (len(errs) > 0) != c.expectError {
+			t.Fatalf("%s: expected error %t, got %v", c.ownerID, c.expectError, errs)
+		}
+	}
+}
+
+func TestAccResourceMongoDBAtlasProject_withTags(t *testing.T) {
+	var project matlas.Project
+
+	resourceName := "mongodbatlas_project.test"
+	projectName := fmt.Sprintf("testacc-project-%s", acctest.RandString(10))
+	orgID := os.Getenv("MONGODB_ATLAS_ORG_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBAtlasProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasProjectConfigWithTags(projectName, orgID, "production"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasProjectExists(resourceName, &project),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "2"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasProjectConfigWithTags(projectName, orgID, "staging"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasProjectExists(resourceName, &project),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestUpdateProjectTags(t *testing.T) {
+	var body map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/api/atlas/v2/groups/5d09d6a59ccf6445652a444a" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if accept := r.Header.Get("Accept"); accept != atlasV2AcceptHeader {
+			t.Errorf("unexpected Accept header %s", accept)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		fmt.Fprint(w, `{"id": "5d09d6a59ccf6445652a444a"}`)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/api/atlas/v1.0/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	tags := []*resourceTag{{Key: "cost-center", Value: "1234"}}
+	if err := updateProjectTags(client, "5d09d6a59ccf6445652a444a", &tags); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := map[string]interface{}{
+		"tags": []interface{}{map[string]interface{}{"key": "cost-center", "value": "1234"}},
+	}
+	if !reflect.DeepEqual(body, expected) {
+		t.Fatalf("expected request body %v, got %v", expected, body)
+	}
+}
+
 func TestAccResourceMongoDBAtlasProject_importBasic(t *testing.T) {
 
 	projectName := fmt.Sprintf("test-acc-%s", acctest.RandString(10))
@@ -78,7 +475,7 @@ func TestAccResourceMongoDBAtlasProject_importBasic(t *testing.T) {
 
 func testAccCheckMongoDBAtlasProjectExists(resourceName string, project *matlas.Project) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*matlas.Client)
+		conn := testAccProvider.Meta().(*MongoDBClient).Atlas
 
 		rs, ok := s.RootModule().Resources[resourceName]
 		if !ok {
@@ -108,7 +505,7 @@ func testAccCheckMongoDBAtlasProjectAttributes(project *matlas.Project, projectN
 }
 
 func testAccCheckMongoDBAtlasProjectDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*matlas.Client)
+	conn := testAccProvider.Meta().(*MongoDBClient).Atlas
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "mongodbatlas_project" {
@@ -141,3 +538,80 @@ func testAccMongoDBAtlasPropjectConfig(projectName, orgID string) string {
 		}
 	`, projectName, orgID)
 }
+
+func testAccMongoDBAtlasProjectConfigWithLimits(projectName, orgID string, clusters int) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_project" "test" {
+			name   = "%s"
+			org_id = "%s"
+
+			limits {
+				name  = "atlas.project.deployment.clusters"
+				value = %d
+			}
+		}
+	`, projectName, orgID, clusters)
+}
+
+func testAccMongoDBAtlasProjectConfigWithTeams(projectName, orgID, teamID, roleNames string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_project" "test" {
+			name   = "%s"
+			org_id = "%s"
+
+			teams {
+				team_id    = "%s"
+				role_names = [%s]
+			}
+		}
+	`, projectName, orgID, teamID, roleNames)
+}
+
+func testAccMongoDBAtlasProjectConfigWithIPAccessList(projectName, orgID string, cidrBlocks ...string) string {
+	entries := ""
+	for _, cidrBlock := range cidrBlocks {
+		entries += fmt.Sprintf(`
+			ip_access_list {
+				cidr_block = "%s"
+				comment    = "cidr block for acc testing"
+			}
+		`, cidrBlock)
+	}
+	return fmt.Sprintf(`
+		resource "mongodbatlas_project" "test" {
+			name   = "%s"
+			org_id = "%s"
+			%s
+		}
+	`, projectName, orgID, entries)
+}
+
+func testAccMongoDBAtlasProjectConfigWithSettings(projectName, orgID string, enabled bool) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_project" "test" {
+			name   = "%[1]s"
+			org_id = "%[2]s"
+
+			is_data_explorer_enabled  = %[3]t
+			is_schema_advisor_enabled = %[3]t
+		}
+	`, projectName, orgID, enabled)
+}
+
+func testAccMongoDBAtlasProjectConfigWithTags(projectName, orgID, environment string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_project" "test" {
+			name   = "%s"
+			org_id = "%s"
+
+			tags {
+				key   = "environment"
+				value = "%s"
+			}
+			tags {
+				key   = "cost-center"
+				value = "1234"
+			}
+		}
+	`, projectName, orgID, environment)
+}
@@ -0,0 +1,270 @@
+package mongodbatlas
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+const (
+	resourcePoliciesPath       = "../v2/orgs/%s/resourcePolicies"
+	errorResourcePolicyCreate  = "error creating resource policy in organization (%s): %s"
+	errorResourcePolicyRead    = "error getting resource policy (%s): %s"
+	errorResourcePolicyUpdate  = "error updating resource policy (%s): %s"
+	errorResourcePolicyDelete  = "error deleting resource policy (%s): %s"
+	errorResourcePolicySetting = "error setting `%s` for resource policy (%s): %s"
+)
+
+// resourcePolicy represents the Cedar policies restricting the resources of an organization.
+// See more: https://www.mongodb.com/docs/atlas/reference/api-resources-spec/v2/#tag/Resource-Policies
+type resourcePolicy struct {
+	ID            string                 `json:"id,omitempty"`
+	OrgID         string                 `json:"orgId,omitempty"`
+	Name          string                 `json:"name,omitempty"`
+	Version       string                 `json:"version,omitempty"`
+	CreatedByUser *resourcePolicyUser    `json:"createdByUser,omitempty"`
+	Policies      []*resourcePolicyEntry `json:"policies,omitempty"`
+}
+
+type resourcePolicyUser struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+type resourcePolicyEntry struct {
+	ID   string `json:"id,omitempty"`
+	Body string `json:"body,omitempty"`
+}
+
+func resourceMongoDBAtlasResourcePolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMongoDBAtlasResourcePolicyCreate,
+		Read:   resourceMongoDBAtlasResourcePolicyRead,
+		Update: resourceMongoDBAtlasResourcePolicyUpdate,
+		Delete: resourceMongoDBAtlasResourcePolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMongoDBAtlasResourcePolicyImportState,
+		},
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"body": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validateResourcePolicyBody,
+							DiffSuppressFunc: resourcePolicyBodyDiffSuppressFunc,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"resource_policy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by_user": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceMongoDBAtlasResourcePolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	orgID := d.Get("org_id").(string)
+
+	policy := new(resourcePolicy)
+	if _, err := doAtlasV2Request(conn, http.MethodPost, fmt.Sprintf(resourcePoliciesPath, orgID), expandResourcePolicy(d), policy); err != nil {
+		return fmt.Errorf(errorResourcePolicyCreate, orgID, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"org_id": orgID,
+		"id":     policy.ID,
+	}))
+
+	return resourceMongoDBAtlasResourcePolicyRead(d, meta)
+}
+
+func resourceMongoDBAtlasResourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+
+	policy, resp, err := getResourcePolicy(conn, ids["org_id"], ids["id"])
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(errorResourcePolicyRead, ids["id"], err)
+	}
+
+	if err := d.Set("name", policy.Name); err != nil {
+		return fmt.Errorf(errorResourcePolicySetting, "name", ids["id"], err)
+	}
+	if err := d.Set("policies", flattenResourcePolicyEntries(policy.Policies)); err != nil {
+		return fmt.Errorf(errorResourcePolicySetting, "policies", ids["id"], err)
+	}
+	if err := d.Set("resource_policy_id", policy.ID); err != nil {
+		return fmt.Errorf(errorResourcePolicySetting, "resource_policy_id", ids["id"], err)
+	}
+	if err := d.Set("version", policy.Version); err != nil {
+		return fmt.Errorf(errorResourcePolicySetting, "version", ids["id"], err)
+	}
+	if err := d.Set("created_by_user", flattenResourcePolicyUser(policy.CreatedByUser)); err != nil {
+		return fmt.Errorf(errorResourcePolicySetting, "created_by_user", ids["id"], err)
+	}
+
+	return nil
+}
+
+func resourceMongoDBAtlasResourcePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+
+	path := fmt.Sprintf(resourcePoliciesPath+"/%s", ids["org_id"], ids["id"])
+	if _, err := doAtlasV2Request(conn, http.MethodPatch, path, expandResourcePolicy(d), nil); err != nil {
+		return fmt.Errorf(errorResourcePolicyUpdate, ids["id"], err)
+	}
+
+	return resourceMongoDBAtlasResourcePolicyRead(d, meta)
+}
+
+func resourceMongoDBAtlasResourcePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+
+	path := fmt.Sprintf(resourcePoliciesPath+"/%s", ids["org_id"], ids["id"])
+	if _, err := doAtlasV2Request(conn, http.MethodDelete, path, nil, nil); err != nil {
+		return fmt.Errorf(errorResourcePolicyDelete, ids["id"], err)
+	}
+	return nil
+}
+
+func resourceMongoDBAtlasResourcePolicyImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*MongoDBClient).Atlas
+
+	parts := strings.SplitN(d.Id(), "-", 2)
+	if len(parts) != 2 {
+		return nil, errors.New("import format error: to import a resource policy, use the format {org_id}-{resource_policy_id}")
+	}
+
+	orgID := parts[0]
+	policyID := parts[1]
+
+	if _, _, err := getResourcePolicy(conn, orgID, policyID); err != nil {
+		return nil, fmt.Errorf("couldn't import resource policy %s in organization %s, error: %s", policyID, orgID, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"org_id": orgID,
+		"id":     policyID,
+	}))
+
+	if err := d.Set("org_id", orgID); err != nil {
+		log.Printf("[WARN] Error setting org_id for (%s): %s", d.Id(), err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func getResourcePolicy(conn *matlas.Client, orgID, policyID string) (*resourcePolicy, *matlas.Response, error) {
+	root := new(resourcePolicy)
+	resp, err := doAtlasV2Request(conn, http.MethodGet, fmt.Sprintf(resourcePoliciesPath+"/%s", orgID, policyID), nil, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
+
+func expandResourcePolicy(d *schema.ResourceData) *resourcePolicy {
+	policy := &resourcePolicy{
+		Name: d.Get("name").(string),
+	}
+	for _, p := range d.Get("policies").([]interface{}) {
+		entry := p.(map[string]interface{})
+		policy.Policies = append(policy.Policies, &resourcePolicyEntry{
+			Body: entry["body"].(string),
+		})
+	}
+	return policy
+}
+
+func flattenResourcePolicyEntries(entries []*resourcePolicyEntry) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0, len(entries))
+	for _, entry := range entries {
+		results = append(results, map[string]interface{}{
+			"id":   entry.ID,
+			"body": entry.Body,
+		})
+	}
+	return results
+}
+
+func flattenResourcePolicyUser(user *resourcePolicyUser) []map[string]interface{} {
+	if user == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"id":   user.ID,
+			"name": user.Name,
+		},
+	}
+}
+
+func validateResourcePolicyBody(v interface{}, k string) (ws []string, es []error) {
+	if strings.TrimSpace(v.(string)) == "" {
+		es = append(es, fmt.Errorf("%q must contain a Cedar policy, got an empty body", k))
+	}
+	return
+}
+
+// resourcePolicyBodyDiffSuppressFunc ignores the leading and trailing whitespace of the policies,
+// e.g. the trailing newline of heredocs.
+func resourcePolicyBodyDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
@@ -0,0 +1,161 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccResourceMongoDBAtlasResourcePolicy_basic(t *testing.T) {
+	resourceName := "mongodbatlas_resource_policy.test"
+	orgID := os.Getenv("MONGODB_ATLAS_ORG_ID")
+	name := fmt.Sprintf("test-acc-policy-%s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBAtlasResourcePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasResourcePolicyConfig(orgID, name, "aws"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasResourcePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "org_id", orgID),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "policies.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "policies.0.id"),
+					resource.TestCheckResourceAttrSet(resourceName, "resource_policy_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "version"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_by_user.0.id"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasResourcePolicyConfig(orgID, name, "gcp"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasResourcePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policies.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceMongoDBAtlasResourcePolicy_importBasic(t *testing.T) {
+	resourceName := "mongodbatlas_resource_policy.test"
+	orgID := os.Getenv("MONGODB_ATLAS_ORG_ID")
+	name := fmt.Sprintf("test-acc-policy-%s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBAtlasResourcePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasResourcePolicyConfig(orgID, name, "aws"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccCheckMongoDBAtlasResourcePolicyImportStateIDFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestValidateResourcePolicyBody(t *testing.T) {
+	cases := []struct {
+		body        string
+		expectError bool
+	}{
+		{body: `forbid (principal, action == cloud::Action::"cluster.createEdit", resource);`, expectError: false},
+		{body: "", expectError: true},
+		{body: " \n\t", expectError: true},
+	}
+
+	for _, c := range cases {
+		_, errs := validateResourcePolicyBody(c.body, "policies.0.body")
+		if c.expectError && len(errs) == 0 {
+			t.Fatalf("expected error for %q", c.body)
+		}
+		if !c.expectError && len(errs) > 0 {
+			t.Fatalf("unexpected error for %q: %v", c.body, errs)
+		}
+	}
+
+	if !resourcePolicyBodyDiffSuppressFunc("policies.0.body", "forbid (principal, action, resource);", "forbid (principal, action, resource);\n", nil) {
+		t.Fatal("expected the trailing newline to be ignored")
+	}
+}
+
+func testAccCheckMongoDBAtlasResourcePolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*MongoDBClient).Atlas
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		ids := decodeStateID(rs.Primary.ID)
+		if _, _, err := getResourcePolicy(conn, ids["org_id"], ids["id"]); err != nil {
+			return fmt.Errorf("resource policy (%s) does not exist", ids["id"])
+		}
+		return nil
+	}
+}
+
+func testAccCheckMongoDBAtlasResourcePolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*MongoDBClient).Atlas
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongodbatlas_resource_policy" {
+			continue
+		}
+
+		ids := decodeStateID(rs.Primary.ID)
+		if _, _, err := getResourcePolicy(conn, ids["org_id"], ids["id"]); err == nil {
+			return fmt.Errorf("resource policy (%s) still exists", ids["id"])
+		}
+	}
+	return nil
+}
+
+func testAccCheckMongoDBAtlasResourcePolicyImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s-%s", rs.Primary.Attributes["org_id"], rs.Primary.Attributes["resource_policy_id"]), nil
+	}
+}
+
+func testAccMongoDBAtlasResourcePolicyConfig(orgID, name, forbiddenProvider string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_resource_policy" "test" {
+			org_id = "%s"
+			name   = "%s"
+
+			policies {
+				body = <<POLICY
+forbid (
+  principal,
+  action == cloud::Action::"cluster.createEdit",
+  resource
+)
+when {
+  context.cluster.cloudProviders.containsAny([cloud::cloudProvider::"%s"])
+};
+POLICY
+			}
+		}
+	`, orgID, name, forbiddenProvider)
+}
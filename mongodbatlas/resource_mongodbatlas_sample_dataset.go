@@ -0,0 +1,176 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+const (
+	sampleDatasetLoadPath     = "groups/%s/sampleDatasetLoad/%s"
+	errorSampleDatasetCreate  = "error loading the sample dataset in cluster (%s): %s"
+	errorSampleDatasetRead    = "error getting the sample dataset load (%s): %s"
+	errorSampleDatasetSetting = "error setting `%s` for sample dataset load (%s): %s"
+)
+
+// sampleDatasetLoad represents a job loading the sample dataset in a cluster.
+// See more: https://docs.atlas.mongodb.com/reference/api/cluster-load-dataset/
+type sampleDatasetLoad struct {
+	ID           string `json:"_id,omitempty"`
+	ClusterName  string `json:"clusterName,omitempty"`
+	CreateDate   string `json:"createDate,omitempty"`
+	CompleteDate string `json:"completeDate,omitempty"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
+	State        string `json:"state,omitempty"`
+}
+
+func resourceMongoDBAtlasSampleDataset() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMongoDBAtlasSampleDatasetCreate,
+		Read:   resourceMongoDBAtlasSampleDatasetRead,
+		Delete: resourceMongoDBAtlasSampleDatasetDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"sample_dataset_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMongoDBAtlasSampleDatasetCreate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+	clusterName := d.Get("cluster_name").(string)
+
+	path := fmt.Sprintf(sampleDatasetLoadPath, projectID, url.PathEscape(clusterName))
+	req, err := conn.NewRequest(context.Background(), http.MethodPost, path, nil)
+	if err != nil {
+		return fmt.Errorf(errorSampleDatasetCreate, clusterName, err)
+	}
+
+	job := new(sampleDatasetLoad)
+	if _, err := conn.Do(context.Background(), req, job); err != nil {
+		return fmt.Errorf(errorSampleDatasetCreate, clusterName, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":   projectID,
+		"cluster_name": clusterName,
+		"id":           job.ID,
+	}))
+
+	if err := waitForSampleDatasetLoad(conn, projectID, job.ID, d.Timeout(schema.TimeoutCreate), 10*time.Second); err != nil {
+		return fmt.Errorf(errorSampleDatasetCreate, clusterName, err)
+	}
+
+	return resourceMongoDBAtlasSampleDatasetRead(d, meta)
+}
+
+func resourceMongoDBAtlasSampleDatasetRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+
+	job, resp, err := getSampleDatasetLoad(conn, ids["project_id"], ids["id"])
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(errorSampleDatasetRead, ids["id"], err)
+	}
+
+	if err := d.Set("sample_dataset_id", job.ID); err != nil {
+		return fmt.Errorf(errorSampleDatasetSetting, "sample_dataset_id", ids["id"], err)
+	}
+	if err := d.Set("state", job.State); err != nil {
+		return fmt.Errorf(errorSampleDatasetSetting, "state", ids["id"], err)
+	}
+	if err := d.Set("created_at", job.CreateDate); err != nil {
+		return fmt.Errorf(errorSampleDatasetSetting, "created_at", ids["id"], err)
+	}
+
+	return nil
+}
+
+func resourceMongoDBAtlasSampleDatasetDelete(d *schema.ResourceData, meta interface{}) error {
+	ids := decodeStateID(d.Id())
+
+	// Atlas doesn't unload the sample dataset, the databases stay in the cluster until dropped.
+	log.Printf("[WARN] the sample dataset is only removed from the state, its databases remain in cluster (%s)", ids["cluster_name"])
+	return nil
+}
+
+func getSampleDatasetLoad(conn *matlas.Client, projectID, jobID string) (*sampleDatasetLoad, *matlas.Response, error) {
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, fmt.Sprintf(sampleDatasetLoadPath, projectID, jobID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(sampleDatasetLoad)
+	resp, err := conn.Do(context.Background(), req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
+
+func waitForSampleDatasetLoad(conn *matlas.Client, projectID, jobID string, timeout, minTimeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"WORKING"},
+		Target:     []string{"COMPLETED"},
+		Refresh:    resourceSampleDatasetLoadRefreshFunc(conn, projectID, jobID),
+		Timeout:    timeout,
+		MinTimeout: minTimeout,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func resourceSampleDatasetLoadRefreshFunc(conn *matlas.Client, projectID, jobID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		job, _, err := getSampleDatasetLoad(conn, projectID, jobID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if job.State == "FAILED" {
+			return nil, "", fmt.Errorf("the sample dataset load (%s) failed: %s", job.ID, job.ErrorMessage)
+		}
+
+		log.Printf("[DEBUG] status of the sample dataset load (%s): %s", job.ID, job.State)
+		return job, job.State, nil
+	}
+}
@@ -0,0 +1,86 @@
+package mongodbatlas
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+func TestAccResourceMongoDBAtlasSampleDataset_basic(t *testing.T) {
+	resourceName := "mongodbatlas_sample_dataset.test"
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+	clusterName := os.Getenv("MONGODB_ATLAS_CLUSTER_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); checkClusterEnv(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasSampleDatasetConfig(projectID, clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cluster_name", clusterName),
+					resource.TestCheckResourceAttr(resourceName, "state", "COMPLETED"),
+					resource.TestCheckResourceAttrSet(resourceName, "sample_dataset_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestWaitForSampleDatasetLoad(t *testing.T) {
+	cases := []struct {
+		name        string
+		states      []string
+		expectError bool
+	}{
+		{name: "completed", states: []string{"WORKING", "WORKING", "COMPLETED"}},
+		{name: "failed", states: []string{"WORKING", "FAILED"}, expectError: true},
+	}
+
+	for _, c := range cases {
+		polls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewEncoder(w).Encode(sampleDatasetLoad{
+				ID:           "5d0f1f74cf09a29120e123cd",
+				State:        c.states[polls],
+				ErrorMessage: "not enough disk space",
+			})
+			polls++
+		}))
+
+		client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		err = waitForSampleDatasetLoad(client, "5d09d6a59ccf6445652a444a", "5d0f1f74cf09a29120e123cd", time.Minute, time.Millisecond)
+		server.Close()
+
+		if c.expectError && err == nil {
+			t.Fatalf("%s: expected error", c.name)
+		}
+		if !c.expectError && err != nil {
+			t.Fatalf("%s: unexpected error: %s", c.name, err)
+		}
+		if polls != len(c.states) {
+			t.Fatalf("%s: expected %d polls, got %d", c.name, len(c.states), polls)
+		}
+	}
+}
+
+func testAccMongoDBAtlasSampleDatasetConfig(projectID, clusterName string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_sample_dataset" "test" {
+			project_id   = "%s"
+			cluster_name = "%s"
+		}
+	`, projectID, clusterName)
+}
@@ -0,0 +1,289 @@
+package mongodbatlas
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/spf13/cast"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+const (
+	searchDeploymentPath         = clusterV2Path + "/search/deployment"
+	errorSearchDeploymentCreate  = "error creating search deployment of cluster (%s): %s"
+	errorSearchDeploymentRead    = "error getting search deployment of cluster (%s): %s"
+	errorSearchDeploymentUpdate  = "error updating search deployment of cluster (%s): %s"
+	errorSearchDeploymentDelete  = "error deleting search deployment of cluster (%s): %s"
+	errorSearchDeploymentSetting = "error setting `%s` for search deployment of cluster (%s): %s"
+)
+
+// searchDeployment represents the dedicated search nodes of a cluster, which run Atlas Search
+// separately from the nodes of the cluster.
+// See more: https://www.mongodb.com/docs/atlas/reference/api-resources-spec/v2/#tag/Atlas-Search/operation/createAtlasSearchDeployment
+type searchDeployment struct {
+	ID        string                  `json:"id,omitempty"`
+	Specs     []*searchDeploymentSpec `json:"specs,omitempty"`
+	StateName string                  `json:"stateName,omitempty"`
+}
+
+type searchDeploymentSpec struct {
+	InstanceSize string `json:"instanceSize,omitempty"`
+	NodeCount    int    `json:"nodeCount,omitempty"`
+}
+
+func resourceMongoDBAtlasSearchDeployment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMongoDBAtlasSearchDeploymentCreate,
+		Read:   resourceMongoDBAtlasSearchDeploymentRead,
+		Update: resourceMongoDBAtlasSearchDeploymentUpdate,
+		Delete: resourceMongoDBAtlasSearchDeploymentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMongoDBAtlasSearchDeploymentImportState,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Hour),
+			Update: schema.DefaultTimeout(3 * time.Hour),
+			Delete: schema.DefaultTimeout(3 * time.Hour),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"specs": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_size": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"node_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(2, 32),
+						},
+					},
+				},
+			},
+			"state_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMongoDBAtlasSearchDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+	clusterName := d.Get("cluster_name").(string)
+
+	deployment := &searchDeployment{Specs: expandSearchDeploymentSpecs(d.Get("specs").([]interface{}))}
+	if _, err := doAtlasV2Request(conn, http.MethodPost, fmt.Sprintf(searchDeploymentPath, projectID, url.PathEscape(clusterName)), deployment, nil); err != nil {
+		return fmt.Errorf(errorSearchDeploymentCreate, clusterName, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":   projectID,
+		"cluster_name": clusterName,
+	}))
+
+	if err := waitForSearchDeployment(conn, projectID, clusterName, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf(errorSearchDeploymentCreate, clusterName, err)
+	}
+
+	return resourceMongoDBAtlasSearchDeploymentRead(d, meta)
+}
+
+func resourceMongoDBAtlasSearchDeploymentRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	clusterName := ids["cluster_name"]
+
+	deployment, resp, err := getSearchDeployment(conn, ids["project_id"], clusterName)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(errorSearchDeploymentRead, clusterName, err)
+	}
+	if deployment.ID == "" {
+		log.Printf("[WARN] search deployment of cluster (%s) was deleted, removing it from state", clusterName)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("specs", flattenSearchDeploymentSpecs(deployment.Specs)); err != nil {
+		return fmt.Errorf(errorSearchDeploymentSetting, "specs", clusterName, err)
+	}
+	if err := d.Set("state_name", deployment.StateName); err != nil {
+		return fmt.Errorf(errorSearchDeploymentSetting, "state_name", clusterName, err)
+	}
+
+	return nil
+}
+
+func resourceMongoDBAtlasSearchDeploymentUpdate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	projectID := ids["project_id"]
+	clusterName := ids["cluster_name"]
+
+	if d.HasChange("specs") {
+		deployment := &searchDeployment{Specs: expandSearchDeploymentSpecs(d.Get("specs").([]interface{}))}
+		if _, err := doAtlasV2Request(conn, http.MethodPatch, fmt.Sprintf(searchDeploymentPath, projectID, url.PathEscape(clusterName)), deployment, nil); err != nil {
+			return fmt.Errorf(errorSearchDeploymentUpdate, clusterName, err)
+		}
+		if err := waitForSearchDeployment(conn, projectID, clusterName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf(errorSearchDeploymentUpdate, clusterName, err)
+		}
+	}
+
+	return resourceMongoDBAtlasSearchDeploymentRead(d, meta)
+}
+
+func resourceMongoDBAtlasSearchDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	projectID := ids["project_id"]
+	clusterName := ids["cluster_name"]
+
+	if _, err := doAtlasV2Request(conn, http.MethodDelete, fmt.Sprintf(searchDeploymentPath, projectID, url.PathEscape(clusterName)), nil, nil); err != nil {
+		return fmt.Errorf(errorSearchDeploymentDelete, clusterName, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"IDLE", "UPDATING", "PAUSED"},
+		Target:     []string{"DELETED"},
+		Refresh:    resourceSearchDeploymentRefreshFunc(conn, projectID, clusterName),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 30 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf(errorSearchDeploymentDelete, clusterName, err)
+	}
+	return nil
+}
+
+func resourceMongoDBAtlasSearchDeploymentImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*MongoDBClient).Atlas
+
+	parts := strings.SplitN(d.Id(), "-", 2)
+	if len(parts) != 2 {
+		return nil, errors.New("import format error: to import a search deployment, use the format {project_id}-{cluster_name}")
+	}
+
+	projectID := parts[0]
+	clusterName := parts[1]
+
+	deployment, _, err := getSearchDeployment(conn, projectID, clusterName)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't import search deployment of cluster %s in project %s, error: %s", clusterName, projectID, err)
+	}
+	if deployment.ID == "" {
+		return nil, fmt.Errorf("couldn't import search deployment of cluster %s in project %s, error: the cluster doesn't have search nodes", clusterName, projectID)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":   projectID,
+		"cluster_name": clusterName,
+	}))
+
+	if err := d.Set("project_id", projectID); err != nil {
+		log.Printf("[WARN] Error setting project_id for (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("cluster_name", clusterName); err != nil {
+		log.Printf("[WARN] Error setting cluster_name for (%s): %s", d.Id(), err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func getSearchDeployment(conn *matlas.Client, projectID, clusterName string) (*searchDeployment, *matlas.Response, error) {
+	root := new(searchDeployment)
+	resp, err := doAtlasV2Request(conn, http.MethodGet, fmt.Sprintf(searchDeploymentPath, projectID, url.PathEscape(clusterName)), nil, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
+
+// waitForSearchDeployment waits until the search nodes of the cluster are provisioned.
+func waitForSearchDeployment(conn *matlas.Client, projectID, clusterName string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"UPDATING"},
+		Target:     []string{"IDLE", "PAUSED"},
+		Refresh:    resourceSearchDeploymentRefreshFunc(conn, projectID, clusterName),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+		Delay:      1 * time.Minute,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+// resourceSearchDeploymentRefreshFunc returns the state of the search deployment, or DELETED once
+// the cluster doesn't have search nodes anymore.
+func resourceSearchDeploymentRefreshFunc(conn *matlas.Client, projectID, clusterName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		deployment, resp, err := getSearchDeployment(conn, projectID, clusterName)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return "", "DELETED", nil
+			}
+			return nil, "", err
+		}
+		if deployment.ID == "" {
+			return "", "DELETED", nil
+		}
+		log.Printf("[DEBUG] status of the search deployment of cluster (%s): %s", clusterName, deployment.StateName)
+		return deployment, deployment.StateName, nil
+	}
+}
+
+func expandSearchDeploymentSpecs(specs []interface{}) []*searchDeploymentSpec {
+	results := make([]*searchDeploymentSpec, 0, len(specs))
+	for _, s := range specs {
+		spec := s.(map[string]interface{})
+		results = append(results, &searchDeploymentSpec{
+			InstanceSize: cast.ToString(spec["instance_size"]),
+			NodeCount:    cast.ToInt(spec["node_count"]),
+		})
+	}
+	return results
+}
+
+func flattenSearchDeploymentSpecs(specs []*searchDeploymentSpec) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0, len(specs))
+	for _, spec := range specs {
+		results = append(results, map[string]interface{}{
+			"instance_size": spec.InstanceSize,
+			"node_count":    spec.NodeCount,
+		})
+	}
+	return results
+}
@@ -0,0 +1,173 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+func TestAccResourceMongoDBAtlasSearchDeployment_basic(t *testing.T) {
+	resourceName := "mongodbatlas_search_deployment.test"
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+	clusterName := os.Getenv("MONGODB_ATLAS_CLUSTER_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); checkClusterEnv(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBAtlasSearchDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasSearchDeploymentConfig(projectID, clusterName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "specs.0.instance_size", "S20_HIGHCPU_NVME"),
+					resource.TestCheckResourceAttr(resourceName, "specs.0.node_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "state_name", "IDLE"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasSearchDeploymentConfig(projectID, clusterName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "specs.0.node_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "state_name", "IDLE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     fmt.Sprintf("%s-%s", projectID, clusterName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMongoDBAtlasSearchDeploymentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*MongoDBClient).Atlas
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongodbatlas_search_deployment" {
+			continue
+		}
+
+		ids := decodeStateID(rs.Primary.ID)
+		deployment, _, err := getSearchDeployment(conn, ids["project_id"], ids["cluster_name"])
+		if err == nil && deployment.ID != "" {
+			return fmt.Errorf("search deployment of cluster (%s) still exists", ids["cluster_name"])
+		}
+	}
+	return nil
+}
+
+func testAccMongoDBAtlasSearchDeploymentConfig(projectID, clusterName string, nodeCount int) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_search_deployment" "test" {
+			project_id   = "%s"
+			cluster_name = "%s"
+
+			specs {
+				instance_size = "S20_HIGHCPU_NVME"
+				node_count    = %d
+			}
+		}
+	`, projectID, clusterName, nodeCount)
+}
+
+func TestResourceSearchDeploymentRefreshFunc(t *testing.T) {
+	cases := []struct {
+		status   int
+		body     string
+		expected string
+	}{
+		{status: http.StatusOK, body: `{"id": "65a0b1c2d3e4f5a6b7c8d9e0", "stateName": "UPDATING"}`, expected: "UPDATING"},
+		{status: http.StatusOK, body: `{"id": "65a0b1c2d3e4f5a6b7c8d9e0", "stateName": "IDLE"}`, expected: "IDLE"},
+		{status: http.StatusOK, body: `{}`, expected: "DELETED"},
+		{status: http.StatusNotFound, body: `{"error": 404, "errorCode": "RESOURCE_NOT_FOUND", "reason": "Not Found"}`, expected: "DELETED"},
+	}
+
+	for _, c := range cases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(c.status)
+			fmt.Fprint(w, c.body)
+		}))
+
+		client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/api/atlas/v1.0/"))
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		_, state, err := resourceSearchDeploymentRefreshFunc(client, "5d09d6a59ccf6445652a444a", "cluster0")()
+		server.Close()
+		if err != nil {
+			t.Fatalf("%s: err: %s", c.body, err)
+		}
+		if state != c.expected {
+			t.Fatalf("%s: expected state %s, got %s", c.body, c.expected, state)
+		}
+	}
+}
+
+func TestResourceMongoDBAtlasSearchDeploymentDelete(t *testing.T) {
+	var requests []string
+	deleted := false
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodDelete {
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if deleted {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error": 404, "errorCode": "RESOURCE_NOT_FOUND", "reason": "Not Found"}`)
+			return
+		}
+		fmt.Fprint(w, `{"id": "65a0b1c2d3e4f5a6b7c8d9e0", "stateName": "IDLE"}`)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/api/atlas/v1.0/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	d := resourceMongoDBAtlasSearchDeployment().TestResourceData()
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":   "5d09d6a59ccf6445652a444a",
+		"cluster_name": "cluster0",
+	}))
+
+	if err := resourceMongoDBAtlasSearchDeploymentDelete(d, &MongoDBClient{Atlas: client}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	path := "/api/atlas/v2/groups/5d09d6a59ccf6445652a444a/clusters/cluster0/search/deployment"
+	expected := []string{"DELETE " + path, "GET " + path}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Fatalf("expected requests %v, got %v", expected, requests)
+	}
+}
+
+func TestExpandSearchDeploymentSpecs(t *testing.T) {
+	specs := []interface{}{
+		map[string]interface{}{"instance_size": "S30_HIGHCPU_NVME", "node_count": 2},
+	}
+
+	expanded := expandSearchDeploymentSpecs(specs)
+	expected := []*searchDeploymentSpec{{InstanceSize: "S30_HIGHCPU_NVME", NodeCount: 2}}
+	if !reflect.DeepEqual(expanded, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, expanded)
+	}
+
+	flattened := flattenSearchDeploymentSpecs(expanded)
+	if !reflect.DeepEqual(flattened, []map[string]interface{}{{"instance_size": "S30_HIGHCPU_NVME", "node_count": 2}}) {
+		t.Fatalf("expected the flattened specs to match the configuration, got %v", flattened)
+	}
+}
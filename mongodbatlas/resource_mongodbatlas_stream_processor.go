@@ -0,0 +1,318 @@
+package mongodbatlas
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+const (
+	streamProcessorsPath        = "../v2/groups/%s/streams/%s/processor"
+	errorStreamProcessorCreate  = "error creating stream processor (%s) in stream instance (%s): %s"
+	errorStreamProcessorRead    = "error getting stream processor (%s) of stream instance (%s): %s"
+	errorStreamProcessorUpdate  = "error updating stream processor (%s) of stream instance (%s): %s"
+	errorStreamProcessorDelete  = "error deleting stream processor (%s) of stream instance (%s): %s"
+	errorStreamProcessorSetting = "error setting `%s` for stream processor (%s): %s"
+)
+
+// streamProcessor represents an aggregation pipeline that processes the data of a stream instance.
+// See more: https://www.mongodb.com/docs/atlas/reference/api-resources-spec/v2/#tag/Streams
+type streamProcessor struct {
+	ID       string          `json:"_id,omitempty"`
+	Name     string          `json:"name,omitempty"`
+	Pipeline json.RawMessage `json:"pipeline,omitempty"`
+	State    string          `json:"state,omitempty"`
+}
+
+func resourceMongoDBAtlasStreamProcessor() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMongoDBAtlasStreamProcessorCreate,
+		Read:   resourceMongoDBAtlasStreamProcessorRead,
+		Update: resourceMongoDBAtlasStreamProcessorUpdate,
+		Delete: resourceMongoDBAtlasStreamProcessorDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMongoDBAtlasStreamProcessorImportState,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"processor_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"pipeline": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateStreamProcessorPipeline,
+				DiffSuppressFunc: streamProcessorPipelineDiffSuppressFunc,
+			},
+			"state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringInSlice([]string{"STARTED", "STOPPED"}, false),
+				DiffSuppressFunc: streamProcessorStateDiffSuppressFunc,
+			},
+			"processor_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMongoDBAtlasStreamProcessorCreate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+	instanceName := d.Get("instance_name").(string)
+	processorName := d.Get("processor_name").(string)
+
+	processor := &streamProcessor{
+		Name:     processorName,
+		Pipeline: json.RawMessage(d.Get("pipeline").(string)),
+	}
+	if _, err := doAtlasV2Request(conn, http.MethodPost, fmt.Sprintf(streamProcessorsPath, projectID, url.PathEscape(instanceName)), processor, nil); err != nil {
+		return fmt.Errorf(errorStreamProcessorCreate, processorName, instanceName, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":     projectID,
+		"instance_name":  instanceName,
+		"processor_name": processorName,
+	}))
+
+	if d.Get("state").(string) == "STARTED" {
+		if err := startStreamProcessor(conn, projectID, instanceName, processorName, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf(errorStreamProcessorCreate, processorName, instanceName, err)
+		}
+	}
+
+	return resourceMongoDBAtlasStreamProcessorRead(d, meta)
+}
+
+func resourceMongoDBAtlasStreamProcessorRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	instanceName := ids["instance_name"]
+	processorName := ids["processor_name"]
+
+	processor, resp, err := getStreamProcessor(conn, ids["project_id"], instanceName, processorName)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(errorStreamProcessorRead, processorName, instanceName, err)
+	}
+
+	pipeline, err := structure.NormalizeJsonString(string(processor.Pipeline))
+	if err != nil {
+		return fmt.Errorf(errorStreamProcessorSetting, "pipeline", processorName, err)
+	}
+	if err := d.Set("pipeline", pipeline); err != nil {
+		return fmt.Errorf(errorStreamProcessorSetting, "pipeline", processorName, err)
+	}
+	if err := d.Set("state", processor.State); err != nil {
+		return fmt.Errorf(errorStreamProcessorSetting, "state", processorName, err)
+	}
+	if err := d.Set("processor_id", processor.ID); err != nil {
+		return fmt.Errorf(errorStreamProcessorSetting, "processor_id", processorName, err)
+	}
+
+	return nil
+}
+
+func resourceMongoDBAtlasStreamProcessorUpdate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	projectID := ids["project_id"]
+	instanceName := ids["instance_name"]
+	processorName := ids["processor_name"]
+	path := streamProcessorPath(projectID, instanceName, processorName)
+
+	oldState, newState := d.GetChange("state")
+	running := oldState.(string) == "STARTED"
+
+	if d.HasChange("pipeline") {
+		// Atlas only modifies the pipeline of a stopped processor.
+		if running {
+			if _, err := doAtlasV2Request(conn, http.MethodPost, path+":stop", nil, nil); err != nil {
+				return fmt.Errorf(errorStreamProcessorUpdate, processorName, instanceName, err)
+			}
+			running = false
+		}
+
+		processor := &streamProcessor{
+			Name:     processorName,
+			Pipeline: json.RawMessage(d.Get("pipeline").(string)),
+		}
+		if _, err := doAtlasV2Request(conn, http.MethodPatch, path, processor, nil); err != nil {
+			return fmt.Errorf(errorStreamProcessorUpdate, processorName, instanceName, err)
+		}
+	}
+
+	switch {
+	case newState.(string) == "STARTED" && !running:
+		if err := startStreamProcessor(conn, projectID, instanceName, processorName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf(errorStreamProcessorUpdate, processorName, instanceName, err)
+		}
+	case newState.(string) == "STOPPED" && running:
+		if _, err := doAtlasV2Request(conn, http.MethodPost, path+":stop", nil, nil); err != nil {
+			return fmt.Errorf(errorStreamProcessorUpdate, processorName, instanceName, err)
+		}
+	}
+
+	return resourceMongoDBAtlasStreamProcessorRead(d, meta)
+}
+
+func resourceMongoDBAtlasStreamProcessorDelete(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	instanceName := ids["instance_name"]
+	processorName := ids["processor_name"]
+
+	if _, err := doAtlasV2Request(conn, http.MethodDelete, streamProcessorPath(ids["project_id"], instanceName, processorName), nil, nil); err != nil {
+		return fmt.Errorf(errorStreamProcessorDelete, processorName, instanceName, err)
+	}
+	return nil
+}
+
+func resourceMongoDBAtlasStreamProcessorImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*MongoDBClient).Atlas
+
+	//The instance and processor names may contain hyphens, so they're separated by a slash.
+	parts := strings.SplitN(d.Id(), "-", 2)
+	if len(parts) != 2 || !strings.Contains(parts[1], "/") {
+		return nil, errors.New("import format error: to import a stream processor, use the format {project_id}-{instance_name}/{processor_name}")
+	}
+
+	projectID := parts[0]
+	i := strings.Index(parts[1], "/")
+	instanceName := parts[1][:i]
+	processorName := parts[1][i+1:]
+
+	if _, _, err := getStreamProcessor(conn, projectID, instanceName, processorName); err != nil {
+		return nil, fmt.Errorf("couldn't import stream processor %s of stream instance %s in project %s, error: %s", processorName, instanceName, projectID, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":     projectID,
+		"instance_name":  instanceName,
+		"processor_name": processorName,
+	}))
+
+	if err := d.Set("project_id", projectID); err != nil {
+		log.Printf("[WARN] Error setting project_id for (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("instance_name", instanceName); err != nil {
+		log.Printf("[WARN] Error setting instance_name for (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("processor_name", processorName); err != nil {
+		log.Printf("[WARN] Error setting processor_name for (%s): %s", d.Id(), err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func streamProcessorPath(projectID, instanceName, processorName string) string {
+	return fmt.Sprintf(streamProcessorsPath+"/%s", projectID, url.PathEscape(instanceName), url.PathEscape(processorName))
+}
+
+func getStreamProcessor(conn *matlas.Client, projectID, instanceName, processorName string) (*streamProcessor, *matlas.Response, error) {
+	root := new(streamProcessor)
+	resp, err := doAtlasV2Request(conn, http.MethodGet, streamProcessorPath(projectID, instanceName, processorName), nil, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root, resp, nil
+}
+
+// startStreamProcessor starts the processor and waits until it reports running.
+func startStreamProcessor(conn *matlas.Client, projectID, instanceName, processorName string, timeout time.Duration) error {
+	if _, err := doAtlasV2Request(conn, http.MethodPost, streamProcessorPath(projectID, instanceName, processorName)+":start", nil, nil); err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"CREATED", "STOPPED"},
+		Target:     []string{"STARTED"},
+		Refresh:    resourceStreamProcessorRefreshFunc(conn, projectID, instanceName, processorName),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func resourceStreamProcessorRefreshFunc(conn *matlas.Client, projectID, instanceName, processorName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		processor, _, err := getStreamProcessor(conn, projectID, instanceName, processorName)
+		if err != nil {
+			return nil, "", err
+		}
+		if processor.State == "FAILED" {
+			return nil, "", fmt.Errorf("the stream processor (%s) failed to start", processorName)
+		}
+		log.Printf("[DEBUG] status of the stream processor (%s): %s", processorName, processor.State)
+		return processor, processor.State, nil
+	}
+}
+
+func validateStreamProcessorPipeline(v interface{}, k string) (ws []string, es []error) {
+	var stages []interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &stages); err != nil {
+		es = append(es, fmt.Errorf("%q must be a JSON array of aggregation stages: %s", k, err))
+	} else if len(stages) == 0 {
+		es = append(es, fmt.Errorf("%q must contain at least one aggregation stage", k))
+	}
+	return
+}
+
+// streamProcessorPipelineDiffSuppressFunc ignores the formatting of the pipeline, e.g. its
+// whitespace or the order of the keys of its stages.
+func streamProcessorPipelineDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	oldPipeline, err := structure.NormalizeJsonString(old)
+	if err != nil {
+		return false
+	}
+	newPipeline, err := structure.NormalizeJsonString(new)
+	if err != nil {
+		return false
+	}
+	return oldPipeline == newPipeline
+}
+
+// streamProcessorStateDiffSuppressFunc treats a processor that was never started, which Atlas
+// reports as CREATED, as stopped.
+func streamProcessorStateDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return old == "CREATED" && new == "STOPPED"
+}
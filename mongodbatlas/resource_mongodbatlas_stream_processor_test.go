@@ -0,0 +1,242 @@
+package mongodbatlas
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+func TestAccResourceMongoDBAtlasStreamProcessor_basic(t *testing.T) {
+	resourceName := "mongodbatlas_stream_processor.test"
+	projectID := os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+	instanceName := os.Getenv("MONGODB_ATLAS_STREAM_INSTANCE_NAME")
+	processorName := fmt.Sprintf("test-acc-processor-%s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); checkStreamInstanceEnv(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBAtlasStreamProcessorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasStreamProcessorConfig(projectID, instanceName, processorName, "STARTED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasStreamProcessorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "processor_name", processorName),
+					resource.TestCheckResourceAttr(resourceName, "state", "STARTED"),
+					resource.TestCheckResourceAttrSet(resourceName, "processor_id"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasStreamProcessorConfig(projectID, instanceName, processorName, "STOPPED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasStreamProcessorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state", "STOPPED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     fmt.Sprintf("%s-%s/%s", projectID, instanceName, processorName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceMongoDBAtlasStreamProcessorCreate(t *testing.T) {
+	var requests []string
+	var sent map[string]interface{}
+	state := "CREATED"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/atlas/v2/groups/5d09d6a59ccf6445652a444a/streams/instance-test/processor":
+			_ = json.NewDecoder(r.Body).Decode(&sent)
+		case r.Method == http.MethodPost:
+			state = "STARTED"
+		}
+		fmt.Fprintf(w, `{
+			"_id": "6580f6f2a9b9b1a2b3c4d5e6",
+			"name": "processor-test",
+			"pipeline": [{"$source": {"connectionName": "sample_stream_solar"}}, {"$emit": {"connectionName": "cluster0", "db": "sample", "coll": "solar"}}],
+			"state": %q
+		}`, state)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/api/atlas/v1.0/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	d := resourceMongoDBAtlasStreamProcessor().TestResourceData()
+	d.Set("project_id", "5d09d6a59ccf6445652a444a")
+	d.Set("instance_name", "instance-test")
+	d.Set("processor_name", "processor-test")
+	d.Set("pipeline", `[
+		{"$source": {"connectionName": "sample_stream_solar"}},
+		{"$emit": {"coll": "solar", "connectionName": "cluster0", "db": "sample"}}
+	]`)
+	d.Set("state", "STARTED")
+
+	if err := resourceMongoDBAtlasStreamProcessorCreate(d, &MongoDBClient{Atlas: client}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	path := "/api/atlas/v2/groups/5d09d6a59ccf6445652a444a/streams/instance-test/processor"
+	expected := []string{
+		"POST " + path,
+		"POST " + path + "/processor-test:start",
+		"GET " + path + "/processor-test",
+		"GET " + path + "/processor-test",
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Fatalf("expected requests %v, got %v", expected, requests)
+	}
+	if stages, ok := sent["pipeline"].([]interface{}); !ok || len(stages) != 2 || sent["name"] != "processor-test" {
+		t.Fatalf("unexpected processor sent %v", sent)
+	}
+	if state := d.Get("state").(string); state != "STARTED" {
+		t.Fatalf("expected the processor to be started, got %s", state)
+	}
+	if id := d.Get("processor_id").(string); id != "6580f6f2a9b9b1a2b3c4d5e6" {
+		t.Fatalf("unexpected processor_id %s", id)
+	}
+}
+
+func TestResourceMongoDBAtlasStreamProcessorUpdate(t *testing.T) {
+	var requests []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+		}
+		fmt.Fprint(w, `{"_id": "6580f6f2a9b9b1a2b3c4d5e6", "name": "processor-test", "pipeline": [{"$source": {"connectionName": "kafka"}}], "state": "STARTED"}`)
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/api/atlas/v1.0/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	state := &terraform.InstanceState{
+		ID: encodeStateID(map[string]string{
+			"project_id":     "5d09d6a59ccf6445652a444a",
+			"instance_name":  "instance-test",
+			"processor_name": "processor-test",
+		}),
+		Attributes: map[string]string{
+			"project_id":     "5d09d6a59ccf6445652a444a",
+			"instance_name":  "instance-test",
+			"processor_name": "processor-test",
+			"pipeline":       `[{"$source":{"connectionName":"sample_stream_solar"}}]`,
+			"state":          "STARTED",
+		},
+	}
+	diff := &terraform.InstanceDiff{Attributes: map[string]*terraform.ResourceAttrDiff{
+		"pipeline": {Old: `[{"$source":{"connectionName":"sample_stream_solar"}}]`, New: `[{"$source":{"connectionName":"kafka"}}]`},
+	}}
+
+	if _, err := resourceMongoDBAtlasStreamProcessor().Apply(state, diff, &MongoDBClient{Atlas: client}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// The running processor is stopped to modify its pipeline, then started again.
+	path := "/api/atlas/v2/groups/5d09d6a59ccf6445652a444a/streams/instance-test/processor/processor-test"
+	expected := []string{"POST " + path + ":stop", "PATCH " + path, "POST " + path + ":start"}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Fatalf("expected requests %v, got %v", expected, requests)
+	}
+}
+
+func TestValidateStreamProcessorPipeline(t *testing.T) {
+	cases := []struct {
+		pipeline    string
+		expectError bool
+	}{
+		{pipeline: `[{"$source": {"connectionName": "kafka"}}]`, expectError: false},
+		{pipeline: `[]`, expectError: true},
+		{pipeline: `{"$source": {"connectionName": "kafka"}}`, expectError: true},
+		{pipeline: `[{"$source": `, expectError: true},
+	}
+
+	for _, c := range cases {
+		_, errs := validateStreamProcessorPipeline(c.pipeline, "pipeline")
+		if c.expectError && len(errs) == 0 {
+			t.Fatalf("expected error for %q", c.pipeline)
+		}
+		if !c.expectError && len(errs) > 0 {
+			t.Fatalf("unexpected error for %q: %v", c.pipeline, errs)
+		}
+	}
+
+	if !streamProcessorPipelineDiffSuppressFunc("pipeline", `[{"$emit":{"coll":"solar","db":"sample"}}]`, "[\n  {\"$emit\": {\"db\": \"sample\", \"coll\": \"solar\"}}\n]\n", nil) {
+		t.Fatal("expected the formatting of the pipeline to be ignored")
+	}
+	if streamProcessorPipelineDiffSuppressFunc("pipeline", `[{"$emit":{"coll":"solar"}}]`, `[{"$emit":{"coll":"lunar"}}]`, nil) {
+		t.Fatal("expected a change of the pipeline to make a diff")
+	}
+}
+
+func testAccCheckMongoDBAtlasStreamProcessorExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*MongoDBClient).Atlas
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		ids := decodeStateID(rs.Primary.ID)
+		if _, _, err := getStreamProcessor(conn, ids["project_id"], ids["instance_name"], ids["processor_name"]); err != nil {
+			return fmt.Errorf("stream processor (%s) does not exist", ids["processor_name"])
+		}
+		return nil
+	}
+}
+
+func testAccCheckMongoDBAtlasStreamProcessorDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*MongoDBClient).Atlas
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongodbatlas_stream_processor" {
+			continue
+		}
+
+		ids := decodeStateID(rs.Primary.ID)
+		if _, _, err := getStreamProcessor(conn, ids["project_id"], ids["instance_name"], ids["processor_name"]); err == nil {
+			return fmt.Errorf("stream processor (%s) still exists", ids["processor_name"])
+		}
+	}
+	return nil
+}
+
+func testAccMongoDBAtlasStreamProcessorConfig(projectID, instanceName, processorName, state string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_stream_processor" "test" {
+			project_id     = "%s"
+			instance_name  = "%s"
+			processor_name = "%s"
+			state          = "%s"
+
+			pipeline = jsonencode([
+				{ "$source" = { "connectionName" = "sample_stream_solar" } },
+				{ "$emit" = { "connectionName" = "__testLog" } }
+			])
+		}
+	`, projectID, instanceName, processorName, state)
+}
@@ -0,0 +1,312 @@
+package mongodbatlas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+const (
+	teamsPath        = "orgs/%s/teams"
+	userByNamePath   = "users/byName/%s"
+	errorTeamCreate  = "error creating team (%s) in organization (%s): %s"
+	errorTeamRead    = "error getting team (%s) of organization (%s): %s"
+	errorTeamUpdate  = "error updating team (%s) of organization (%s): %s"
+	errorTeamDelete  = "error deleting team (%s) of organization (%s): %s"
+	errorTeamSetting = "error setting `%s` for team (%s): %s"
+)
+
+// team is a team of users of an organization. The roles of a team are granted per project.
+// See more: https://docs.atlas.mongodb.com/reference/api/teams/
+type team struct {
+	ID        string   `json:"id,omitempty"`
+	Name      string   `json:"name,omitempty"`
+	Usernames []string `json:"usernames,omitempty"`
+}
+
+// teamUser is a member of a team.
+type teamUser struct {
+	ID       string `json:"id,omitempty"`
+	Username string `json:"username,omitempty"`
+}
+
+func resourceMongoDBAtlasTeams() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMongoDBAtlasTeamsCreate,
+		Read:   resourceMongoDBAtlasTeamsRead,
+		Update: resourceMongoDBAtlasTeamsUpdate,
+		Delete: resourceMongoDBAtlasTeamsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMongoDBAtlasTeamsImportState,
+		},
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"usernames": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"team_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// A team only has roles in the projects it's assigned to, so they're managed by
+			// mongodbatlas_project_team rather than silently ignored here.
+			"role_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Removed:  "a team only manages its members, use the mongodbatlas_project_team resource to assign the team to a project with roles",
+			},
+		},
+	}
+}
+
+func resourceMongoDBAtlasTeamsCreate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	orgID := d.Get("org_id").(string)
+	name := d.Get("name").(string)
+
+	req, err := conn.NewRequest(context.Background(), http.MethodPost, fmt.Sprintf(teamsPath, orgID), &team{
+		Name:      name,
+		Usernames: expandStringSet(d.Get("usernames").(*schema.Set)),
+	})
+	if err != nil {
+		return fmt.Errorf(errorTeamCreate, name, orgID, err)
+	}
+
+	created := new(team)
+	if _, err := conn.Do(context.Background(), req, created); err != nil {
+		return fmt.Errorf(errorTeamCreate, name, orgID, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"org_id": orgID,
+		"id":     created.ID,
+	}))
+
+	return resourceMongoDBAtlasTeamsRead(d, meta)
+}
+
+func resourceMongoDBAtlasTeamsRead(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	orgID := ids["org_id"]
+	teamID := ids["id"]
+
+	result, resp, err := getTeam(conn, orgID, teamID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(errorTeamRead, teamID, orgID, err)
+	}
+
+	users, err := listTeamUsers(conn, orgID, teamID)
+	if err != nil {
+		return fmt.Errorf(errorTeamRead, teamID, orgID, err)
+	}
+	usernames := make([]string, 0, len(users))
+	for _, user := range users {
+		usernames = append(usernames, user.Username)
+	}
+
+	if err := d.Set("name", result.Name); err != nil {
+		return fmt.Errorf(errorTeamSetting, "name", teamID, err)
+	}
+	if err := d.Set("usernames", usernames); err != nil {
+		return fmt.Errorf(errorTeamSetting, "usernames", teamID, err)
+	}
+	if err := d.Set("team_id", result.ID); err != nil {
+		return fmt.Errorf(errorTeamSetting, "team_id", teamID, err)
+	}
+
+	return nil
+}
+
+func resourceMongoDBAtlasTeamsUpdate(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	orgID := ids["org_id"]
+	teamID := ids["id"]
+
+	if d.HasChange("name") {
+		req, err := conn.NewRequest(context.Background(), http.MethodPatch, fmt.Sprintf(teamsPath+"/%s", orgID, teamID), &team{Name: d.Get("name").(string)})
+		if err != nil {
+			return fmt.Errorf(errorTeamUpdate, teamID, orgID, err)
+		}
+		if _, err := conn.Do(context.Background(), req, nil); err != nil {
+			return fmt.Errorf(errorTeamUpdate, teamID, orgID, err)
+		}
+	}
+
+	if d.HasChange("usernames") {
+		o, n := d.GetChange("usernames")
+		if err := updateTeamUsers(conn, orgID, teamID, expandStringSet(o.(*schema.Set)), expandStringSet(n.(*schema.Set))); err != nil {
+			return fmt.Errorf(errorTeamUpdate, teamID, orgID, err)
+		}
+	}
+
+	return resourceMongoDBAtlasTeamsRead(d, meta)
+}
+
+func resourceMongoDBAtlasTeamsDelete(d *schema.ResourceData, meta interface{}) error {
+	//Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	orgID := ids["org_id"]
+	teamID := ids["id"]
+
+	req, err := conn.NewRequest(context.Background(), http.MethodDelete, fmt.Sprintf(teamsPath+"/%s", orgID, teamID), nil)
+	if err != nil {
+		return fmt.Errorf(errorTeamDelete, teamID, orgID, err)
+	}
+	if _, err := conn.Do(context.Background(), req, nil); err != nil {
+		return fmt.Errorf(errorTeamDelete, teamID, orgID, err)
+	}
+	return nil
+}
+
+func resourceMongoDBAtlasTeamsImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*MongoDBClient).Atlas
+
+	parts := strings.SplitN(d.Id(), "-", 2)
+	if len(parts) != 2 {
+		return nil, errors.New("import format error: to import a team, use the format {org_id}-{team_id}")
+	}
+
+	orgID := parts[0]
+	teamID := parts[1]
+
+	if _, _, err := getTeam(conn, orgID, teamID); err != nil {
+		return nil, fmt.Errorf("couldn't import team %s of organization %s, error: %s", teamID, orgID, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"org_id": orgID,
+		"id":     teamID,
+	}))
+
+	if err := d.Set("org_id", orgID); err != nil {
+		log.Printf("[WARN] Error setting org_id for (%s): %s", d.Id(), err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func getTeam(conn *matlas.Client, orgID, teamID string) (*team, *matlas.Response, error) {
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, fmt.Sprintf(teamsPath+"/%s", orgID, teamID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(team)
+	resp, err := conn.Do(context.Background(), req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return result, resp, nil
+}
+
+func listTeamUsers(conn *matlas.Client, orgID, teamID string) ([]*teamUser, error) {
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, fmt.Sprintf(teamsPath+"/%s/users", orgID, teamID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	root := new(struct {
+		Results []*teamUser `json:"results"`
+	})
+	if _, err := conn.Do(context.Background(), req, root); err != nil {
+		return nil, err
+	}
+	return root.Results, nil
+}
+
+// updateTeamUsers adds the users that are only in the new usernames to the team, and removes the
+// ones that are only in the old usernames. Atlas identifies the users of a team by their ID, so
+// the IDs are looked up by username.
+func updateTeamUsers(conn *matlas.Client, orgID, teamID string, oldUsernames, newUsernames []string) error {
+	current := make(map[string]bool, len(oldUsernames))
+	for _, username := range oldUsernames {
+		current[username] = true
+	}
+	declared := make(map[string]bool, len(newUsernames))
+	for _, username := range newUsernames {
+		declared[username] = true
+	}
+
+	var added []*teamUser
+	for _, username := range newUsernames {
+		if current[username] {
+			continue
+		}
+		user, err := getUserByName(conn, username)
+		if err != nil {
+			return fmt.Errorf("error getting user (%s): %s", username, err)
+		}
+		added = append(added, &teamUser{ID: user.ID})
+	}
+	if len(added) > 0 {
+		req, err := conn.NewRequest(context.Background(), http.MethodPost, fmt.Sprintf(teamsPath+"/%s/users", orgID, teamID), added)
+		if err != nil {
+			return err
+		}
+		if _, err := conn.Do(context.Background(), req, nil); err != nil {
+			return err
+		}
+	}
+
+	for _, username := range oldUsernames {
+		if declared[username] {
+			continue
+		}
+		user, err := getUserByName(conn, username)
+		if err != nil {
+			return fmt.Errorf("error getting user (%s): %s", username, err)
+		}
+		req, err := conn.NewRequest(context.Background(), http.MethodDelete, fmt.Sprintf(teamsPath+"/%s/users/%s", orgID, teamID, user.ID), nil)
+		if err != nil {
+			return err
+		}
+		if _, err := conn.Do(context.Background(), req, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getUserByName(conn *matlas.Client, username string) (*teamUser, error) {
+	req, err := conn.NewRequest(context.Background(), http.MethodGet, fmt.Sprintf(userByNamePath, url.PathEscape(username)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	user := new(teamUser)
+	if _, err := conn.Do(context.Background(), req, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
@@ -0,0 +1,157 @@
+package mongodbatlas
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	matlas "github.com/mongodb/go-client-mongodb-atlas/mongodbatlas"
+)
+
+func TestAccResourceMongoDBAtlasTeams_basic(t *testing.T) {
+	resourceName := "mongodbatlas_teams.test"
+	orgID := os.Getenv("MONGODB_ATLAS_ORG_ID")
+	username := os.Getenv("MONGODB_ATLAS_TEAMS_USERNAME")
+	name := fmt.Sprintf("test-acc-%s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); checkTeamsEnv(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBAtlasTeamsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasTeamsConfig(orgID, name, username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "team_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "usernames.#", "1"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasTeamsConfig(orgID, name+"-updated", username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMongoDBAtlasTeamsDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*MongoDBClient).Atlas
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongodbatlas_teams" {
+			continue
+		}
+
+		ids := decodeStateID(rs.Primary.ID)
+		if _, _, err := getTeam(conn, ids["org_id"], ids["id"]); err == nil {
+			return fmt.Errorf("team (%s) still exists", ids["id"])
+		}
+	}
+	return nil
+}
+
+func testAccMongoDBAtlasTeamsConfig(orgID, name, username string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_teams" "test" {
+			org_id    = "%s"
+			name      = "%s"
+			usernames = ["%s"]
+		}
+	`, orgID, name, username)
+}
+
+func TestResourceMongoDBAtlasTeams_roleNames(t *testing.T) {
+	cases := []struct {
+		raw         map[string]interface{}
+		expectError bool
+	}{
+		{
+			raw:         map[string]interface{}{"org_id": "5d09d6a59ccf6445652a444a", "name": "team", "usernames": []interface{}{"user@example.com"}},
+			expectError: false,
+		},
+		{
+			raw:         map[string]interface{}{"org_id": "5d09d6a59ccf6445652a444a", "name": "team", "usernames": []interface{}{"user@example.com"}, "role_names": []interface{}{"GROUP_READ_ONLY"}},
+			expectError: true,
+		},
+	}
+
+	for i, c := range cases {
+		rc, err := config.NewRawConfig(c.raw)
+		if err != nil {
+			t.Fatalf("case %d: err: %s", i, err)
+		}
+
+		_, errs := resourceMongoDBAtlasTeams().Validate(terraform.NewResourceConfig(rc))
+		if c.expectError && len(errs) == 0 {
+			t.Fatalf("case %d: expected error", i)
+		}
+		if !c.expectError && len(errs) > 0 {
+			t.Fatalf("case %d: unexpected errors: %v", i, errs)
+		}
+		if c.expectError && !strings.Contains(errs[0].Error(), "mongodbatlas_project_team") {
+			t.Fatalf("case %d: expected the error to point to mongodbatlas_project_team, got: %s", i, errs[0])
+		}
+	}
+}
+
+func TestUpdateTeamUsers(t *testing.T) {
+	var requests []string
+	var added []*teamUser
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/users/byName/"):
+			username := strings.TrimPrefix(r.URL.Path, "/users/byName/")
+			fmt.Fprintf(w, `{"id": "id-%s", "username": "%s"}`, username, username)
+		case r.Method == http.MethodPost:
+			_ = json.NewDecoder(r.Body).Decode(&added)
+			fmt.Fprint(w, `{}`)
+		default:
+			fmt.Fprint(w, `{}`)
+		}
+	}))
+	defer server.Close()
+
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if err := updateTeamUsers(client, "5d09d6a59ccf6445652a444a", "team-a", []string{"a", "b"}, []string{"b", "c"}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := []string{
+		"GET /users/byName/c",
+		"POST /orgs/5d09d6a59ccf6445652a444a/teams/team-a/users",
+		"GET /users/byName/a",
+		"DELETE /orgs/5d09d6a59ccf6445652a444a/teams/team-a/users/id-a",
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Fatalf("expected requests %v, got %v", expected, requests)
+	}
+	if len(added) != 1 || added[0].ID != "id-c" {
+		t.Fatalf("expected only user c to be added, got %+v", added)
+	}
+
+	requests = nil
+	if err := updateTeamUsers(client, "5d09d6a59ccf6445652a444a", "team-a", []string{"b"}, []string{"b"}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(requests) != 0 {
+		t.Fatalf("expected no requests without changes, got %v", requests)
+	}
+}